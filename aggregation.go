@@ -0,0 +1,132 @@
+package moderation
+
+import "github.com/finnbear/moderation/internal/radix"
+
+// Aggregation is how the levels of the words in a text are combined into the
+// level of each type, which is compared to the thresholds for Mild, Moderate,
+// and Severe
+//
+// Words that overlap or touch (e.g. "ass" and the false positive "assassin")
+// are combined by summing before any other aggregation, so that false
+// positives still cancel out.
+type Aggregation int
+
+const (
+	// AggregateSum sums the levels of every word, the default
+	AggregateSum Aggregation = iota
+
+	// AggregateMax uses the level of the most severe word
+	AggregateMax
+
+	// AggregateDistinctSum sums the levels of every word, counting repeated
+	// words once (e.g. "ass ass ass" is the same as "ass")
+	AggregateDistinctSum
+
+	// AggregateDensity sums the levels of every word per 100 characters of
+	// text, where shorter texts count as 100 characters long
+	AggregateDensity
+)
+
+// aggregator combines the levels of hits according to an Aggregation
+type aggregator struct {
+	aggregation Aggregation
+
+	// Aggregated levels of every cluster so far, except the open one
+	levels [countableTypes]int
+
+	// Hits that overlap or touch, which more hits may yet be added to
+	open    bool
+	cluster span
+	summed  [countableTypes]int
+	longest *radix.Node
+
+	// The longest words of clusters that were already aggregated
+	seen []*radix.Node
+}
+
+// add adds the levels of a hit, which must not end before any other hit that
+// was added
+func (aggregator *aggregator) add(hit hit, levels [countableTypes]int) {
+	if aggregator.aggregation == AggregateSum {
+		// Clusters would make no difference
+		for i, level := range levels {
+			aggregator.levels[i] += level
+		}
+		return
+	}
+
+	if aggregator.open && hit.start <= aggregator.cluster.end {
+		if hit.start < aggregator.cluster.start {
+			aggregator.cluster.start = hit.start
+		}
+		if hit.end > aggregator.cluster.end {
+			aggregator.cluster.end = hit.end
+		}
+		if hit.node.Depth() > aggregator.longest.Depth() {
+			aggregator.longest = hit.node
+		}
+		for i, level := range levels {
+			aggregator.summed[i] += level
+		}
+		return
+	}
+
+	aggregator.close()
+	aggregator.open = true
+	aggregator.cluster = hit.span
+	aggregator.summed = levels
+	aggregator.longest = hit.node
+}
+
+// close aggregates the open cluster, if any
+func (aggregator *aggregator) close() {
+	if !aggregator.open {
+		return
+	}
+	aggregator.open = false
+
+	switch aggregator.aggregation {
+	case AggregateMax:
+		for i, level := range aggregator.summed {
+			if level > aggregator.levels[i] {
+				aggregator.levels[i] = level
+			}
+		}
+		return
+	case AggregateDistinctSum:
+		for _, node := range aggregator.seen {
+			if node == aggregator.longest {
+				return
+			}
+		}
+		aggregator.seen = append(aggregator.seen, aggregator.longest)
+	}
+
+	for i, level := range aggregator.summed {
+		aggregator.levels[i] += level
+	}
+}
+
+// total returns the aggregated levels of a text of the given length, without
+// preventing more hits from being added
+func (aggregator *aggregator) total(length int) [countableTypes]int {
+	levels := aggregator.levels
+	if aggregator.open {
+		// Close a copy, clipping seen so that it can't append to its backing
+		// array
+		closed := *aggregator
+		closed.seen = closed.seen[:len(closed.seen):len(closed.seen)]
+		closed.close()
+		levels = closed.levels
+	}
+
+	if aggregator.aggregation == AggregateDensity {
+		if length < 100 {
+			length = 100
+		}
+		for i, level := range levels {
+			levels[i] = level * 100 / length
+		}
+	}
+	return levels
+}
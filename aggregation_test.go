@@ -0,0 +1,71 @@
+package moderation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestAggregation(t *testing.T) {
+	// "ass" is profane level 2, "fuck" profane level 2
+	type TestCase struct {
+		aggregation Aggregation
+		phrase      string
+		profane     int
+	}
+	long := strings.Repeat("The quick brown fox jumps over the lazy dog. ", 10)
+	testCases := []TestCase{
+		{AggregateSum, "ass ass ass", 6},
+		{AggregateMax, "ass ass ass", 2},
+		{AggregateDistinctSum, "ass ass ass", 2},
+		{AggregateDensity, "ass ass ass", 6},
+		{AggregateSum, "ass fuck ass", 6},
+		{AggregateMax, "ass fuck ass", 2},
+		{AggregateDistinctSum, "ass fuck ass", 4},
+		{AggregateDensity, long + "ass fuck ass", 6 * 100 / len(long+"ass fuck ass")},
+		{AggregateMax, "assassin", 0},
+		{AggregateDistinctSum, "assassin, assassin", 0},
+		{AggregateMax, "you're a dumbass", 2},
+	}
+	for _, testCase := range testCases {
+		filter := NewFilter()
+		filter.SetAggregation(testCase.aggregation)
+		details := filter.ScanDetailed(testCase.phrase)
+		if details.Scores[0] != testCase.profane {
+			t.Errorf("aggregation=%d phrase=\"%s\" profane=%d expected=%d", testCase.aggregation, testCase.phrase, details.Scores[0], testCase.profane)
+		}
+
+		// The aggregation must be the same when streaming
+		scanner := filter.NewScanner()
+		for _, word := range strings.SplitAfter(testCase.phrase, " ") {
+			scanner.WriteString(word)
+		}
+		if scanner.Result() != details.Type {
+			t.Errorf("aggregation=%d phrase=\"%s\" streamed=%b expected=%b", testCase.aggregation, testCase.phrase, scanner.Result(), details.Type)
+		}
+	}
+}
+
+func TestSetThresholds(t *testing.T) {
+	filter := NewFilter()
+	filter.SetThresholds(Profane, 3, 5, 7)
+	if result := filter.Scan("ass"); result.Is(Profane) {
+		t.Errorf("result=%b below threshold", result)
+	}
+	if result := filter.Scan("ass ass"); !result.Is(Profane) || result.Is(Profane&Moderate) {
+		t.Errorf("result=%b expected mild", result)
+	}
+	if result := filter.Scan("sex"); !result.Is(Sexual) {
+		t.Errorf("result=%b other types should be unaffected", result)
+	}
+	if result := filter.Scan("ass", WithThresholds(1, 2, 3)); !result.Is(Profane & Moderate) {
+		t.Errorf("result=%b options should override filter", result)
+	}
+}
+
+func ExampleFilter_SetAggregation() {
+	filter := NewFilter()
+	filter.SetAggregation(AggregateMax)
+	fmt.Println(Scan("ass ass ass").Is(Profane&Severe), filter.Scan("ass ass ass").Is(Profane&Severe))
+	// Output: true false
+}
@@ -0,0 +1,100 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Category is a countable type, which is counted from the levels of each word
+// in the dictionary
+type Category struct {
+	// Lowercase name of the category (e.g. "profane"), which is also its
+	// column in a wordlist
+	Name string
+
+	Type Type
+}
+
+// maxCategoryName is the greatest length of a category name in bytes, which
+// WriteDictionary stores in a single byte
+const maxCategoryName = 255
+
+var categories = struct {
+	sync.Mutex
+	list []Category
+}{
+	list: []Category{
+		{"profane", Profane},
+		{"offensive", Offensive},
+		{"sexual", Sexual},
+		{"mean", Mean},
+		{"violent", Violent},
+		{"selfharm", SelfHarm},
+		{"distress", Distress},
+	},
+}
+
+// RegisterCategory adds a countable type with the given name (e.g.
+// "gambling"), returning a Type that may be used the same way as the built-in
+// ones (e.g. gambling & Moderate)
+//
+// Registered categories are shared by every Filter, which all start with no
+// words in the category. Words are added to it with SetWordLevel (or AddWord).
+//
+// The registry is global to the process, and categories can't be
+// unregistered, so independent packages that register categories share the
+// limit of 9 registered categories, and must not register the same name.
+// Call RegisterCategory during initialization (e.g. from a package level
+// variable) rather than once per Filter.
+//
+// Names are limited to 255 bytes, so that they fit in dictionaries written by
+// WriteDictionary.
+func RegisterCategory(name string) (Type, error) {
+	name = strings.ToLower(name)
+	if name == "" {
+		return 0, errors.New("moderation: empty category name")
+	}
+	if len(name) > maxCategoryName {
+		return 0, fmt.Errorf("moderation: category name of %d bytes is longer than %d bytes", len(name), maxCategoryName)
+	}
+
+	categories.Lock()
+	defer categories.Unlock()
+
+	for _, category := range categories.list {
+		if category.Name == name {
+			return 0, fmt.Errorf("moderation: category %q already exists", name)
+		}
+	}
+	if len(categories.list) == countableTypes {
+		return 0, fmt.Errorf("moderation: cannot register category %q, as there are already %d categories", name, countableTypes)
+	}
+
+	category := Category{Name: name, Type: 0b111 << (len(categories.list) * 3)}
+	categories.list = append(categories.list, category)
+	return category.Type, nil
+}
+
+// Categories returns every countable type, built-in then registered
+func Categories() []Category {
+	categories.Lock()
+	defer categories.Unlock()
+	return append([]Category(nil), categories.list...)
+}
+
+// LookupCategory returns the countable type with the given name, if any
+func LookupCategory(name string) (Type, bool) {
+	name = strings.ToLower(name)
+
+	categories.Lock()
+	defer categories.Unlock()
+
+	for _, category := range categories.list {
+		if category.Name == name {
+			return category.Type, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,96 @@
+package moderation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+// category returns the registered category with the given name, registering
+// it if necessary
+func category(t *testing.T, name string) Type {
+	if category, ok := LookupCategory(name); ok {
+		return category
+	}
+	category, err := RegisterCategory(name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return category
+}
+
+func TestCategories(t *testing.T) {
+	if category, ok := LookupCategory("Profane"); !ok || category != Profane {
+		t.Errorf("expected built-in category, got %b", category)
+	}
+	if _, ok := LookupCategory("nonexistent"); ok {
+		t.Error("expected no category")
+	}
+
+	gambling := category(t, "gambling")
+	if gambling.Is(Profane | Offensive | Sexual | Mean | Violent | SelfHarm | Distress | Spam | ContactInfo) {
+		t.Errorf("registered category %b overlaps built-in categories", gambling)
+	}
+	if !(gambling & Moderate).Is(Any) {
+		t.Error("expected registered category to be included in Any")
+	}
+	if _, err := RegisterCategory("Gambling"); err == nil {
+		t.Error("expected error registering duplicate category")
+	}
+	if _, err := RegisterCategory(""); err == nil {
+		t.Error("expected error registering empty category")
+	}
+	if _, err := RegisterCategory(strings.Repeat("a", 256)); err == nil {
+		t.Error("expected error registering category with a name longer than 255 bytes")
+	}
+
+	found := false
+	for _, category := range Categories() {
+		if category.Name == "gambling" && category.Type == gambling {
+			found = true
+		}
+	}
+	if !found {
+		t.Error("registered category not listed")
+	}
+
+	filter := NewFilter()
+	other := NewFilter()
+	if err := filter.SetWordLevel("Casino", gambling, 2); err != nil {
+		t.Fatal(err)
+	}
+	if err := filter.SetWordLevel("shit", gambling, 1); err != nil {
+		t.Fatal(err)
+	}
+
+	if result := filter.Scan("online c4sino"); !result.Is(gambling&Moderate) || result.Is(gambling&Severe) || result.Is(Profane) {
+		t.Errorf("registered category not detected with correct severity, got %b", result)
+	}
+	if result := filter.Scan("shit"); !result.Is(gambling) || !result.Is(Profane) {
+		t.Errorf("expected existing levels to be kept, got %b", result)
+	}
+	if other.Scan("casino").Is(gambling) {
+		t.Error("registered category words leaked to another filter")
+	}
+
+	if censored, _ := filter.Censor("casino night", gambling); censored != "c***** night" {
+		t.Errorf("expected registered category to be censored, got \"%s\"", censored)
+	}
+
+	filter.SetThresholds(gambling, 3, 4, 5)
+	if filter.Scan("casino").Is(gambling) {
+		t.Error("expected thresholds of registered category to be used")
+	}
+}
+
+func ExampleRegisterCategory() {
+	drugs, err := RegisterCategory("drugs")
+	if err != nil {
+		panic(err)
+	}
+
+	filter := NewFilter()
+	filter.SetWordLevel("cocaine", drugs, 3)
+	fmt.Println(filter.Scan("cocaine").Is(drugs&Severe), filter.Scan("coffee").Is(drugs))
+	// Output: true false
+}
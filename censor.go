@@ -1,45 +1,181 @@
 package moderation
 
-import "golang.org/x/exp/utf8string"
+import (
+	"sort"
+	"unicode"
+	"unicode/utf8"
+)
 
+// CensorReplacment is the rune that Censor replaces characters with by
+// default
+//
+// Deprecated: Changing it affects every caller of Censor in the program, and
+// is not safe for concurrent use. Use WithReplacement instead.
 var CensorReplacment rune = '*'
 
-// Censor returns a string with all but the first character of any inappropriate
-// segment replaced with CensorReplacment
+// Censor returns a string with any inappropriate segment censored, by default
+// by replacing all but its first character with '*', as well as the number of
+// characters that were replaced
+//
+// It is currently Experimental
+func Censor(text string, types Type, opts ...Option) (censoredText string, replaced int) {
+	return defaultFilter().Censor(text, types, opts...)
+}
+
+// Censor returns a string with any inappropriate segment censored, by default
+// by replacing all but its first character with '*', as well as the number of
+// characters that were replaced
+//
+// A word is only censored if the text as a whole is of the given types, and
+// the word is not cancelled out by a false positive it is part of (e.g. the
+// "ass" in "assassin").
 //
-// It is currently Experimental and not fully tested
-func Censor(text string, types Type) (censoredText string, replaced int) {
+// It is currently Experimental
+func (filter *Filter) Censor(text string, types Type, opts ...Option) (censoredText string, replaced int) {
 	// Fast path
-	if len(text) == 0 || !Scan(text).Is(types) {
+	if len(text) == 0 {
+		return text, 0
+	}
+
+	options := newOptions(opts)
+
+	var hits []hit
+	var state scanState
+	state.init(filter, options)
+	state.onHit = func(hit hit) {
+		hits = append(hits, hit)
+	}
+	state.scanString(text, 0)
+	scanResult := state.finish()
+	if !scanResult.Is(types) {
 		return text, 0
 	}
 
-	str := utf8string.NewString(text)
+	matches := censorMatches(text, hits, scanResult&types, state.thresholds)
+	if len(matches) == 0 {
+		return text, 0
+	}
+
+	strategy := options.strategy
+	if strategy == nil {
+		strategy = MaskKeepFirst(options.replacement)
+	}
+
+	censored := make([]byte, 0, len(text))
+	end := 0
+	for _, match := range matches {
+		replacement := strategy(match)
+		censored = append(censored, text[end:match.Start]...)
+		censored = append(censored, replacement...)
+		replaced += replacedCount(match.Text, replacement)
+		end = match.End
+	}
+	censored = append(censored, text[end:]...)
 
-	censored := make([]rune, 0, str.RuneCount())
+	return string(censored), replaced
+}
 
-	start := 0
+// censorMatches returns the sorted, non-overlapping matches that should be
+// censored given which types of the text are to be censored, and the
+// thresholds that the type of each match is found with
+func censorMatches(text string, hits []hit, types Type, thresholds *[countableTypes]thresholds) (matches []Match) {
+	sort.Slice(hits, func(i, j int) bool {
+		return hits[i].start < hits[j].start
+	})
 
-	// TODO: scan ahead for false positives
+	// Hits that overlap or touch form a cluster, such as the "ass" and the
+	// false positive "assi" that cancels it out in "assassin". Only types that
+	// are still positive after summing the cluster are censored.
+	for clusterStart := 0; clusterStart < len(hits); {
+		clusterEnd := clusterStart + 1
+		end := hits[clusterStart].end
+		for clusterEnd < len(hits) && hits[clusterEnd].start <= end {
+			if hits[clusterEnd].end > end {
+				end = hits[clusterEnd].end
+			}
+			clusterEnd++
+		}
+		cluster := hits[clusterStart:clusterEnd]
+		clusterStart = clusterEnd
 
-	for i := start; i <= str.RuneCount(); i++ {
-		slice := str.Slice(start, i)
-		if /* (i == str.RuneCount() || str.At(i) == ' ') && */ Scan(slice).Is(types) {
-			for j := start; j <= i; j++ {
-				slice2 := str.Slice(j, i)
-				if !Scan(slice2).Is(types) {
-					censored = append(censored, []rune(str.Slice(start, j))...)
-					replaced += i - j
-					for k := 0; k < i-j; k++ {
-						censored = append(censored, CensorReplacment)
-					}
+		var levels [countableTypes]int
+		for _, hit := range cluster {
+			for i, level := range hit.levels() {
+				levels[i] += level
+			}
+		}
+
+		for _, hit := range cluster {
+			for i, level := range hit.levels() {
+				if level > 0 && levels[i] > 0 && types&(0b111<<(i*3)) != 0 {
+					matches = appendMatch(matches, hit.match(text, thresholds), text, thresholds)
 					break
 				}
 			}
-			start = i
 		}
 	}
-	censored = append(censored, []rune(str.Slice(start, str.RuneCount()))...)
-	censoredText = string(censored)
 	return
 }
+
+// appendMatch appends match to matches, which are sorted by start, combining
+// it with the last match if they overlap
+func appendMatch(matches []Match, match Match, text string, thresholds *[countableTypes]thresholds) []Match {
+	last := len(matches) - 1
+	if last < 0 || match.Start >= matches[last].End {
+		return append(matches, match)
+	}
+
+	combined := &matches[last]
+	if match.End > combined.End {
+		combined.End = match.End
+		combined.Text = text[combined.Start:combined.End]
+	}
+	if len(match.Word) > len(combined.Word) {
+		combined.Word = match.Word
+	}
+	for i, level := range match.Levels {
+		combined.Levels[i] += level
+	}
+	combined.Replaced = combined.Replaced || match.Replaced
+	combined.typ = levelsType(combined.Levels, thresholds)
+	return matches
+}
+
+// replacedCount returns the number of characters of original that are masked
+// by replacing it with replacement, which excludes any letters and digits they
+// start or end with in common (e.g. the first letter kept by MaskKeepFirst),
+// but not other characters, as one that was already masked (e.g. "*") is
+// masked again
+func replacedCount(original, replacement string) int {
+	for len(original) > 0 && len(replacement) > 0 {
+		originalRune, originalSize := utf8.DecodeRuneInString(original)
+		replacementRune, replacementSize := utf8.DecodeRuneInString(replacement)
+		if originalRune != replacementRune || !isKept(originalRune) {
+			break
+		}
+		original = original[originalSize:]
+		replacement = replacement[replacementSize:]
+	}
+	for len(original) > 0 && len(replacement) > 0 {
+		originalRune, originalSize := utf8.DecodeLastRuneInString(original)
+		replacementRune, replacementSize := utf8.DecodeLastRuneInString(replacement)
+		if originalRune != replacementRune || !isKept(originalRune) {
+			break
+		}
+		original = original[:len(original)-originalSize]
+		replacement = replacement[:len(replacement)-replacementSize]
+	}
+	return utf8.RuneCountInString(original)
+}
+
+// isKept returns whether a character that is the same after censoring was
+// kept, rather than masked
+func isKept(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r)
+}
+
+func appendRune(buf []byte, r rune) []byte {
+	var encoded [utf8.UTFMax]byte
+	n := utf8.EncodeRune(encoded[:], r)
+	return append(buf, encoded[:n]...)
+}
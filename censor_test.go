@@ -0,0 +1,89 @@
+package moderation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestCensor(t *testing.T) {
+	type TestCase struct {
+		phrase   string
+		censored string
+		replaced int
+	}
+	testCases := []TestCase{
+		{"hello", "hello", 0},
+		{"", "", 0},
+		{"sh1t", "s***", 3},
+		{"what the fuck, shit", "what the f***, s***", 6},
+		{"what the fučk", "what the f***", 3},
+		{"f*u*c*k", "f******", 6},
+		{"you're a dumbass", "you're a d******", 6},
+		{"assassin", "assassin", 0},
+		{"you ass, assassin", "you a**, assassin", 2},
+	}
+	for _, testCase := range testCases {
+		censored, replaced := Censor(testCase.phrase, Inappropriate|Mean)
+		if censored != testCase.censored {
+			t.Errorf("phrase=\"%s\" censored=\"%s\" expected=\"%s\"", testCase.phrase, censored, testCase.censored)
+		}
+		if replaced != testCase.replaced {
+			t.Errorf("phrase=\"%s\" replaced=%d expected=%d", testCase.phrase, replaced, testCase.replaced)
+		}
+	}
+
+	// Only types that are asked for are censored
+	if censored, _ := Censor("you're a dumb sexy", Sexual); censored != "you're a dumb s**y" {
+		t.Errorf("censored=\"%s\"", censored)
+	}
+	if censored, _ := Censor("sh1t", Profane&Severe); censored != "sh1t" {
+		t.Errorf("censored=\"%s\"", censored)
+	}
+}
+
+func TestCensorLong(t *testing.T) {
+	sentence := "I had called upon my friend, Mr. Sherlock Holmes, one day in the autumn of last year and found him in deep conversation with a very stout shit. "
+	text := strings.Repeat(sentence, 1000)
+	censored, replaced := Censor(text, Inappropriate)
+	if replaced != 3000 || strings.Contains(censored, "shit") {
+		t.Errorf("replaced=%d", replaced)
+	}
+}
+
+func ExampleCensor() {
+	fmt.Println(Censor("what the sh1t", Inappropriate))
+	// Output: what the s*** 3
+}
+
+func TestCensorStrategy(t *testing.T) {
+	type TestCase struct {
+		strategy CensorStrategy
+		censored string
+	}
+	testCases := []TestCase{
+		{MaskAll('#'), "what the #### you #####"},
+		{MaskKeepFirst('-'), "what the s--- you b----"},
+		{MaskKeepFirstAndLast('*'), "what the s**t you b***h"},
+		{Grawlix(), "what the @#$% you @#$%!"},
+		{Token("[removed]"), "what the [removed] you [removed]"},
+		{ByCategory(map[Type]string{Profane: "[profanity]"}, Token("[removed]")), "what the [profanity] you [removed]"},
+		{ByCategory(map[Type]string{Offensive: "[offensive]"}, Token("[removed]")), "what the [removed] you [offensive]"},
+		{func(match Match) string { return "<" + match.Word + ">" }, "what the <shit> you <bitch>"},
+	}
+	for _, testCase := range testCases {
+		censored, replaced := Censor("what the sh1t you b1tch", Inappropriate, WithStrategy(testCase.strategy))
+		if censored != testCase.censored {
+			t.Errorf("censored=\"%s\" expected=\"%s\"", censored, testCase.censored)
+		}
+		if replaced == 0 {
+			t.Errorf("censored=\"%s\" replaced=0", censored)
+		}
+	}
+}
+
+func ExampleWithStrategy() {
+	censored, _ := Censor("what the sh1t", Inappropriate, WithStrategy(Token("[removed]")))
+	fmt.Println(censored)
+	// Output: what the [removed]
+}
@@ -0,0 +1,381 @@
+package moderation
+
+import (
+	"unicode/utf8"
+)
+
+// contactAnalyzer detects contact information (email addresses, phone
+// numbers, URLs, social handles, and messaging invites) as text is scanned,
+// including obfuscated forms like "john at gmail dot com"
+//
+// The text is split into tokens, which are names (runs of letters, digits,
+// and underscores), '@' or "at", '.' or "dot", and other punctuation. Like in
+// Scan, accents are removed and confusable characters (e.g. Cyrillic 'о') are
+// replaced first.
+// Separators like spaces are not tokens, but are remembered so that a
+// sentence ending in a period is not mistaken for a URL.
+type contactAnalyzer struct {
+	// The name being read, normalized by normalizePIIRune
+	name        [16]byte
+	nameLength  int // bytes, which may exceed len(name)
+	nameDigits  int
+	nameLetters int
+
+	// Whether the previous token ended right before the current one
+	adjacent bool
+	previous contactKeyword
+
+	// Email addresses, by how much of "local@domain.tld" was seen
+	emailStage int
+
+	// URLs, by how much of "host.tld" was seen
+	hostStage int
+	host      contactKeyword
+	spokenDot bool // whether the dot was "dot"
+
+	// Phone numbers, by the groups of digits with only separators between
+	groups     [15]int
+	groupCount int
+	digits     int
+	lastGroup  digitGroup
+	spoken     bool // whether any digits were spoken, like "five"
+	phone      int  // severity of the most severe phone number
+
+	// Social handles, such as "@john"
+	handlePending bool
+
+	email    bool
+	url      bool
+	handle   bool
+	platform bool // messaging platform mentioned
+	intent   bool // invite, such as "add me" or "my snap"
+}
+
+// contactKeyword is a kind of name that is significant to contact detection
+type contactKeyword uint8
+
+const (
+	keywordNone contactKeyword = iota
+	keywordAt
+	keywordDot
+	keywordDigit
+	keywordTLD
+	keywordScheme
+	keywordWWW
+	keywordShortHost // hosts that are followed by "me", like "t.me"
+	keywordMe
+	keywordMy
+	keywordVerb     // followed by "me" to form an invite
+	keywordMedium   // preceded by "my" to form an invite
+	keywordPlatform // messaging platforms, which are also a medium
+	keywordInvite   // invites on their own, like "hmu"
+)
+
+var contactKeywords = map[string]contactKeyword{
+	"at":  keywordAt,
+	"dot": keywordDot,
+
+	"zero": keywordDigit, "one": keywordDigit, "two": keywordDigit,
+	"three": keywordDigit, "four": keywordDigit, "five": keywordDigit,
+	"six": keywordDigit, "seven": keywordDigit, "eight": keywordDigit,
+	"nine": keywordDigit,
+	// Portuguese and Spanish, except those that are common words
+	"dois": keywordDigit, "tres": keywordDigit,
+	"quatro": keywordDigit, "cinco": keywordDigit, "seis": keywordDigit,
+	"sete": keywordDigit, "oito": keywordDigit, "nove": keywordDigit,
+	"cero": keywordDigit, "uno": keywordDigit, "cuatro": keywordDigit,
+	"siete": keywordDigit, "ocho": keywordDigit, "nueve": keywordDigit,
+
+	// Excludes TLDs that are common words, like "me", "in", and "to"
+	"com": keywordTLD, "net": keywordTLD, "org": keywordTLD,
+	"edu": keywordTLD, "gov": keywordTLD, "io": keywordTLD,
+	"gg": keywordTLD, "co": keywordTLD, "xyz": keywordTLD,
+	"info": keywordTLD, "biz": keywordTLD, "tv": keywordTLD,
+	"app": keywordTLD, "dev": keywordTLD, "ly": keywordTLD,
+	"ru": keywordTLD, "uk": keywordTLD, "br": keywordTLD,
+	"fr": keywordTLD, "ca": keywordTLD, "au": keywordTLD,
+	"online": keywordTLD, "site": keywordTLD,
+
+	"http":  keywordScheme,
+	"https": keywordScheme,
+	"www":   keywordWWW,
+	"t":     keywordShortHost,
+	"wa":    keywordShortHost,
+
+	"me": keywordMe,
+	"my": keywordMy,
+
+	"add": keywordVerb, "dm": keywordVerb, "pm": keywordVerb,
+	"text": keywordVerb, "call": keywordVerb, "message": keywordVerb,
+	"msg": keywordVerb, "follow": keywordVerb,
+
+	"number": keywordMedium, "phone": keywordMedium, "cell": keywordMedium,
+	"email": keywordMedium,
+
+	"snapchat": keywordPlatform, "snap": keywordPlatform,
+	"instagram": keywordPlatform, "insta": keywordPlatform,
+	"ig": keywordPlatform, "whatsapp": keywordPlatform,
+	"telegram": keywordPlatform, "discord": keywordPlatform,
+	"kik": keywordPlatform, "skype": keywordPlatform,
+	"wechat": keywordPlatform, "tiktok": keywordPlatform,
+	"facebook": keywordPlatform, "fb": keywordPlatform,
+	"twitter": keywordPlatform, "gmail": keywordPlatform,
+	"hotmail": keywordPlatform, "outlook": keywordPlatform,
+
+	"hmu": keywordInvite,
+}
+
+// add adds a rune of the original text (before sanitization), with the given
+// decomposition (see norm.Properties)
+func (contact *contactAnalyzer) add(textRune rune, decomposition []byte) {
+	if textRune < utf8.RuneSelf {
+		contact.addChar(normalizePIIRune(textRune))
+		return
+	}
+	runes, count := unaccented(textRune, decomposition)
+	for _, unaccentedRune := range runes[:count] {
+		contact.addChar(normalizePIIRune(unaccentedRune))
+	}
+}
+
+// addChar adds a character normalized by normalizePIIRune
+func (contact *contactAnalyzer) addChar(char byte) {
+	switch {
+	case isPIILetter(char), char == '_':
+		contact.addName(char)
+		contact.nameLetters++
+		return
+	case isPIIDigit(char):
+		contact.addName(char)
+		contact.nameDigits++
+		return
+	}
+	contact.endName()
+
+	switch char {
+	case '@':
+		contact.at(false)
+	case '.':
+		contact.dot(false)
+	case ':':
+		if contact.adjacent && contact.previous == keywordScheme {
+			contact.url = true
+		}
+		contact.other()
+	case ' ', '\t', '\n', '\r', '-', '+', '/', '(', ')', '[', ']', '{', '}', '<', '>', '"', '\'':
+		contact.adjacent = false
+	default:
+		contact.other()
+	}
+}
+
+// addName adds a character to the name being read
+func (contact *contactAnalyzer) addName(char byte) {
+	if contact.nameLength < len(contact.name) {
+		contact.name[contact.nameLength] = char
+	}
+	contact.nameLength++
+}
+
+// endName is called at the end of each name
+func (contact *contactAnalyzer) endName() {
+	if contact.nameLength == 0 {
+		return
+	}
+	keyword := keywordNone
+	if contact.nameLength <= len(contact.name) {
+		keyword = contactKeywords[string(contact.name[:contact.nameLength])]
+	}
+	digits := contact.nameDigits
+	if contact.nameLetters > 0 {
+		digits = 0
+	}
+	year := digits == 4 && isYear(contact.name[:4])
+	contact.nameLength = 0
+	contact.nameDigits = 0
+	contact.nameLetters = 0
+
+	switch keyword {
+	case keywordAt:
+		contact.at(true)
+	case keywordDot:
+		contact.dot(true)
+	default:
+		contact.word(keyword, digits, year)
+	}
+}
+
+// word is called with each name other than "at" and "dot", along with the
+// number of digits if it consists only of digits, and whether it looks like a
+// year
+func (contact *contactAnalyzer) word(keyword contactKeyword, digits int, year bool) {
+	switch {
+	case keyword == keywordDigit:
+		// Spoken digits form a single group
+		if contact.previous != keywordDigit || contact.groupCount == 0 {
+			contact.addGroup(digitGroup{})
+		}
+		contact.spoken = true
+		contact.digits++
+		contact.groups[contact.groupCount-1]++
+		contact.lastGroup.digits++
+	case digits > 0:
+		contact.addGroup(digitGroup{digits: digits, year: year})
+	default:
+		contact.endNumber()
+	}
+
+	switch contact.emailStage {
+	case 2:
+		contact.emailStage = 3
+	case 4:
+		if keyword == keywordTLD && (contact.adjacent || contact.spokenDot) {
+			contact.email = true
+		}
+		contact.emailStage = 3
+	default:
+		contact.emailStage = 1
+	}
+
+	if contact.hostStage == 2 && (contact.adjacent || contact.spokenDot) {
+		if keyword == keywordTLD || contact.host == keywordWWW || (contact.host == keywordShortHost && keyword == keywordMe) {
+			contact.url = true
+		}
+	}
+	contact.hostStage = 1
+	contact.host = keyword
+
+	if contact.handlePending && contact.adjacent {
+		contact.handle = true
+	}
+	contact.handlePending = false
+
+	switch {
+	case keyword == keywordInvite:
+		contact.intent = true
+	case keyword == keywordMe && (contact.previous == keywordVerb || contact.previous == keywordPlatform):
+		contact.intent = true
+	case (keyword == keywordMedium || keyword == keywordPlatform) && contact.previous == keywordMy:
+		contact.intent = true
+	}
+	if keyword == keywordPlatform {
+		contact.platform = true
+	}
+
+	contact.previous = keyword
+	contact.adjacent = true
+}
+
+// addGroup adds a group of digits to the phone number being read, or starts
+// a new one
+func (contact *contactAnalyzer) addGroup(group digitGroup) {
+	if contact.groupCount > 0 && splitsNumber(contact.lastGroup, group) {
+		contact.endNumber()
+	}
+	if contact.groupCount == len(contact.groups) {
+		// Too many digits to be a phone number
+		contact.groupCount = 0
+	}
+	contact.groups[contact.groupCount] = group.digits
+	contact.groupCount++
+	contact.digits += group.digits
+	contact.lastGroup = group
+}
+
+// endNumber is called at the end of each phone number
+func (contact *contactAnalyzer) endNumber() {
+	if contact.digits >= 7 && contact.digits <= 15 &&
+		(contact.spoken || isPhoneNumber(contact.groups[:contact.groupCount], false)) {
+		severity := 2
+		if contact.digits >= 10 {
+			severity = 3
+		}
+		if severity > contact.phone {
+			contact.phone = severity
+		}
+	}
+	contact.groupCount = 0
+	contact.digits = 0
+	contact.spoken = false
+}
+
+// at is called with each '@', or "at" if spoken
+func (contact *contactAnalyzer) at(spoken bool) {
+	if contact.emailStage == 1 {
+		contact.emailStage = 2
+	} else {
+		contact.emailStage = 0
+	}
+	contact.hostStage = 0
+	contact.endNumber()
+	contact.handlePending = !spoken && !contact.adjacent
+	contact.previous = keywordAt
+	contact.adjacent = true
+}
+
+// dot is called with each '.', or "dot" if spoken
+func (contact *contactAnalyzer) dot(spoken bool) {
+	if contact.emailStage == 3 {
+		contact.emailStage = 4
+	} else {
+		contact.emailStage = 0
+	}
+	contact.spokenDot = spoken
+	if contact.hostStage == 1 && (contact.adjacent || spoken) {
+		contact.hostStage = 2
+	} else {
+		contact.hostStage = 0
+	}
+	if spoken {
+		// Phone numbers may contain '.', but not "dot"
+		contact.endNumber()
+	}
+	contact.handlePending = false
+	contact.previous = keywordDot
+	contact.adjacent = true
+}
+
+// other is called with each punctuation character that is not part of any
+// kind of contact information
+func (contact *contactAnalyzer) other() {
+	contact.emailStage = 0
+	contact.hostStage = 0
+	contact.endNumber()
+	contact.handlePending = false
+	contact.previous = keywordNone
+	contact.adjacent = true
+}
+
+// severity returns the severity of the contact information, from 0 (none) to
+// 3 (severe)
+//
+// Email addresses, URLs, and phone numbers with an area code are Severe,
+// shorter phone numbers are Moderate, as is more than one of a handle, a
+// messaging platform, and an invite, and otherwise a handle or messaging
+// platform on its own is Mild.
+func (contact contactAnalyzer) severity() int {
+	// The current name and number may be the last
+	contact.endName()
+	contact.endNumber()
+
+	if contact.email || contact.url || contact.phone == 3 {
+		return 3
+	}
+	if contact.phone == 2 {
+		return 2
+	}
+
+	signals := 0
+	for _, signal := range [...]bool{contact.handle, contact.platform, contact.intent} {
+		if signal {
+			signals++
+		}
+	}
+	if signals > 1 {
+		return 2
+	}
+	if contact.handle || contact.platform {
+		return 1
+	}
+	return 0
+}
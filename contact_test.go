@@ -0,0 +1,120 @@
+package moderation
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestContactInfo(t *testing.T) {
+	// severity is 0 for none, or 1, 2, or 3 for Mild, Moderate, or Severe
+	type TestCase struct {
+		phrase   string
+		severity int
+	}
+	testCases := []TestCase{
+		{"hello there", 0},
+		{"I'm at home. Net result is good", 0},
+		{"look at the dot on the map", 0},
+		{"meet me at 5.30", 0},
+		{"I have 3 cats and 4 dogs", 0},
+		{"add me as a friend", 0},
+		{"one of them is two years old", 0},
+		{"1999 2000 2001", 0},
+		{"scores 1000 2000 3000", 0},
+		{"2024-10-18", 0},
+		{"on 18/10/2024 at 10:30", 0},
+		{"from 2023.12.01 to 2024.01.31", 0},
+		{"I have 1234567 xp", 0},
+		{"score 100 200 300 400", 0},
+		{"ISBN 978 3 16 148410 0", 0},
+		{"12:30 2024 10 18 5", 0},
+
+		// Email addresses
+		{"john@gmail.com", 3},
+		{"email me at John.Smith_99@mail.example.co.uk!", 3},
+		{"john at gmail dot com", 3},
+		{"john (at) gmail (dot) com", 3},
+		{"john [at] gmail [dot] com", 3},
+		{"joão@exemplo.com.br", 3},
+		{"jоhn@gmаil.cоm", 3}, // Cyrillic о and а
+		{"ｊｏｈｎ@ｇｍａｉｌ.ｃｏｍ", 3}, // fullwidth
+		{"john＠gmail．com", 3},
+
+		// Phone numbers
+		{"555-123-4567", 3},
+		{"call (555) 123 4567", 3},
+		{"+55 11 91234-5678", 3},
+		{"555.1234", 2},
+		{"5551234567", 3},
+		{"01 23 45 67 89", 3},
+		{"５５５-１２３-４５６７", 3},
+		{"five five five one two three four", 2},
+		{"555 one two three four five six", 2},
+		{"555 one two three four five six seven", 3},
+		{"cinco cinco cinco um dois três quatro", 0},
+		{"nove nove oito sete seis cinco quatro", 2},
+
+		// URLs
+		{"check out example.com", 3},
+		{"https://example", 3},
+		{"www.example", 3},
+		{"example dot com", 3},
+		{"discord.gg/abcdef", 3},
+		{"t.me/john", 3},
+
+		// Handles, platforms, and invites
+		{"@john", 1},
+		{"ask @john_99 about it", 1},
+		{"do you have snapchat", 1},
+		{"add me on snapchat", 2},
+		{"my insta is @john", 2},
+		{"snap me", 2},
+		{"hmu on discord", 2},
+		{"my number is 555 1234 567", 3},
+	}
+
+	for _, testCase := range testCases {
+		result := Scan(testCase.phrase)
+
+		severity := 0
+		if result.Is(ContactInfo & Severe) {
+			severity = 3
+		} else if result.Is(ContactInfo & Moderate) {
+			severity = 2
+		} else if result.Is(ContactInfo) {
+			severity = 1
+		}
+
+		if severity != testCase.severity {
+			t.Errorf("phrase=\"%s\" severity=%d expected=%d", testCase.phrase, severity, testCase.severity)
+		}
+	}
+}
+
+func TestContactInfoScanner(t *testing.T) {
+	// Contact information may be split across writes
+	scanner := NewScanner()
+	for _, part := range []string{"john a", "t gma", "il d", "ot c", "om"} {
+		scanner.WriteString(part)
+	}
+	if !scanner.Result().Is(ContactInfo & Severe) {
+		t.Errorf("expected severe contact info, got %b", scanner.Result())
+	}
+}
+
+func TestContactInfoNotAny(t *testing.T) {
+	// Contact information on its own doesn't affect checks for Any
+	if result := Scan("john@gmail.com"); result.Is(Any) || !result.Is(ContactInfo) {
+		t.Errorf("expected only contact info, got %b", result)
+	}
+}
+
+func ExampleScan_contactInfo() {
+	fmt.Println(Scan("see you tomorrow").Is(ContactInfo))
+	fmt.Println(Scan("add me on snapchat").Is(ContactInfo & Moderate))
+	fmt.Println(Scan("john at gmail dot com").Is(ContactInfo & Severe))
+	// Output:
+	// false
+	// true
+	// true
+}
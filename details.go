@@ -0,0 +1,48 @@
+package moderation
+
+// Details is the detailed result of a scan, which includes the raw levels
+// that the severity of each type was derived from
+type Details struct {
+	// The sum of the levels of every word for each of Profane, Offensive,
+	// Sexual, Mean, Violent, SelfHarm, and Distress
+	Levels [countableTypes]int
+
+	// The levels of every word for each of Profane, Offensive, Sexual, Mean,
+	// Violent, SelfHarm, and Distress combined according to the Aggregation of the
+	// filter (by default the same as Levels), which are compared to the
+	// thresholds for Mild, Moderate, and Severe
+	Scores [countableTypes]int
+
+	// The part of Levels that came from false positives (e.g. "assassin"),
+	// which is zero or negative
+	FalsePositives [countableTypes]int
+
+	// The percentage of characters that contribute to spam (such as uppercase
+	// and repeated letters), or zero if the text is too short to tell
+	SpamPercent int
+
+	// Same as the result of Scan
+	Type Type
+}
+
+// ScanDetailed is like Scan, but returns the details of the result
+//
+// Equivalent to calling ScanDetailed on a Filter returned by NewFilter
+func ScanDetailed(text string, opts ...Option) Details {
+	return defaultFilter().ScanDetailed(text, opts...)
+}
+
+// ScanDetailed is like Scan, but returns the details of the result, which is
+// useful for ranking texts by how inappropriate they are
+func (filter *Filter) ScanDetailed(text string, opts ...Option) Details {
+	var state scanState
+	state.init(filter, newOptions(opts))
+	state.scanString(text, 0)
+	state.finish()
+	return state.details()
+}
+
+// Details is like Result, but returns the details of the result
+func (scanner *Scanner) Details() Details {
+	return scanner.state.details()
+}
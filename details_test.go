@@ -0,0 +1,42 @@
+package moderation
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestScanDetailed(t *testing.T) {
+	phrases := []string{"hello", "sh1t", "fuck ass shit", "you're a dumbass", "assassin", "HELLO THERE", "duuuuuuuuumb"}
+	for _, phrase := range phrases {
+		details := ScanDetailed(phrase)
+		if details.Type != Scan(phrase) {
+			t.Errorf("phrase=\"%s\" type=%b scan=%b", phrase, details.Type, Scan(phrase))
+		}
+
+		var levels [countableTypes]int
+		for _, match := range ScanMatches(phrase) {
+			for i, level := range match.Levels {
+				levels[i] += level
+			}
+		}
+		if details.Levels != levels {
+			t.Errorf("phrase=\"%s\" levels=%v matches=%v", phrase, details.Levels, levels)
+		}
+	}
+
+	if details := ScanDetailed("fuck ass shit"); details.Levels[0] != 6 {
+		t.Errorf("expected profane level 6, got %d", details.Levels[0])
+	}
+	if details := ScanDetailed("assassin"); details.Levels[0] != 0 || details.FalsePositives[0] >= 0 {
+		t.Errorf("expected false positive, got %+v", details)
+	}
+	if details := ScanDetailed("HELLO THERE"); details.SpamPercent <= 30 {
+		t.Errorf("expected spam, got %+v", details)
+	}
+}
+
+func ExampleScanDetailed() {
+	details := ScanDetailed("fuck ass shit")
+	fmt.Println(details.Levels[0], details.Type.Is(Profane&Severe))
+	// Output: 6 true
+}
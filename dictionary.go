@@ -0,0 +1,102 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/finnbear/moderation/internal/radix"
+	"io"
+	"io/ioutil"
+)
+
+// An encoded dictionary starts with dictionaryMagic and a version byte,
+// followed by a byte with the number of columns of levels, the name of the
+// category of each column (a byte with its length, then the name), and
+// finally the encoded tree (see radix.Tree.Encode)
+const (
+	dictionaryMagic   = "MDIC"
+	dictionaryVersion = 1
+)
+
+// LoadDictionary returns a Filter whose dictionary is read from r, in the
+// compact binary format written by WriteDictionary, such as wordlists.bin
+//
+// It is faster to load than a wordlist (see LoadWordlist), and also contains
+// allowed words. Any registered categories that the dictionary has levels
+// for must be registered before it is loaded.
+func LoadDictionary(r io.Reader) (*Filter, error) {
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	return decodeDictionary(string(data))
+}
+
+// WriteDictionary writes the dictionary of the filter to w, in the format read
+// by LoadDictionary
+//
+// The levels of the built-in categories are always written, and those of
+// registered categories only if any word has a level for them.
+// WriteDictionary must not be called concurrently with modifying the filter.
+func (filter *Filter) WriteDictionary(w io.Writer) error {
+	used := builtinCategories
+	filter.tree.Traverse(func(word string, levels radix.Levels) {
+		for i := used; i < len(levels); i++ {
+			if levels[i] != 0 {
+				used = i + 1
+			}
+		}
+	})
+
+	list := Categories()
+	if used > len(list) {
+		return errors.New("moderation: dictionary has levels for an unregistered category")
+	}
+	buf := append([]byte(dictionaryMagic), dictionaryVersion, byte(used))
+	for _, category := range list[:used] {
+		buf = append(buf, byte(len(category.Name)))
+		buf = append(buf, category.Name...)
+	}
+	buf = filter.tree.Encode(buf, used)
+
+	_, err := w.Write(buf)
+	return err
+}
+
+// decodeDictionary returns a Filter with the encoded dictionary
+func decodeDictionary(data string) (*Filter, error) {
+	if len(data) < len(dictionaryMagic)+2 || data[:len(dictionaryMagic)] != dictionaryMagic {
+		return nil, errors.New("moderation: not an encoded dictionary")
+	}
+	data = data[len(dictionaryMagic):]
+	if data[0] != dictionaryVersion {
+		return nil, fmt.Errorf("moderation: unsupported dictionary version %d", data[0])
+	}
+
+	columns := make([]int, data[1])
+	data = data[2:]
+	for i := range columns {
+		if len(data) == 0 || len(data) < 1+int(data[0]) {
+			return nil, errors.New("moderation: encoded dictionary is truncated")
+		}
+		name := data[1 : 1+data[0]]
+		data = data[1+len(name):]
+
+		category, ok := LookupCategory(name)
+		if !ok {
+			return nil, fmt.Errorf("moderation: dictionary has levels for unknown category %q", name)
+		}
+		columns[i] = categoryIndex(category)
+	}
+
+	tree, rest, err := radix.Decode(data, columns)
+	if err != nil {
+		return nil, fmt.Errorf("moderation: %v", err)
+	}
+	if len(rest) > 0 {
+		return nil, fmt.Errorf("moderation: %d unexpected bytes after encoded dictionary", len(rest))
+	}
+
+	filter := newFilter()
+	filter.tree = tree
+	return filter, nil
+}
@@ -0,0 +1,144 @@
+package moderation
+
+import (
+	"bytes"
+	"fmt"
+	"github.com/finnbear/moderation/internal/radix"
+	"io/ioutil"
+	"math/rand"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoadDictionary(t *testing.T) {
+	// The generated file is the same as the default dictionary
+	file, err := os.Open("wordlists.bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	filter, err := LoadDictionary(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := filter.WriteDictionary(&buf); err != nil {
+		t.Fatal(err)
+	}
+	if buf.String() != wordlistDictionary {
+		t.Error("dictionary changed after loading and writing it")
+	}
+
+	// And the same as the generated wordlist
+	wordlist, err := ioutil.ReadFile("wordlists.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadWordlist(bytes.NewReader(wordlist))
+	if err != nil {
+		t.Fatal(err)
+	}
+	mismatches := 0
+	loaded.tree.Traverse(func(word string, levels radix.Levels) {
+		if filter.tree.Get(word) != levels && mismatches < 10 {
+			t.Errorf("word=\"%s\" dictionary=%v wordlist=%v", word, filter.tree.Get(word), levels)
+			mismatches++
+		}
+	})
+	if loaded.tree.Len() != filter.tree.Len() {
+		t.Errorf("wordlist has %d words, dictionary has %d", loaded.tree.Len(), filter.tree.Len())
+	}
+}
+
+func TestFilterWriteDictionary(t *testing.T) {
+	gambling := category(t, "gambling")
+	filter := NewFilter()
+	filter.AddPhrase("place your bets", 0, 0, 0, 1)
+	filter.SetWordLevel("casino", gambling, 2)
+	filter.AllowWord("Scunthorpe")
+	filter.RemoveWord("shit")
+
+	var buf bytes.Buffer
+	if err := filter.WriteDictionary(&buf); err != nil {
+		t.Fatal(err)
+	}
+	loaded, err := LoadDictionary(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, phrase := range []string{"place your bets", "placeyourbets", "casino", "I live in Scunthorpe", "shit", "fuck", "go die"} {
+		if loaded.Scan(phrase) != filter.Scan(phrase) {
+			t.Errorf("phrase=\"%s\" loaded=%b written=%b", phrase, loaded.Scan(phrase), filter.Scan(phrase))
+		}
+	}
+	if !loaded.Scan("casino").Is(gambling & Moderate) {
+		t.Error("registered category not loaded")
+	}
+	if loaded.tree.Len() != filter.tree.Len() {
+		t.Errorf("loaded %d words, expected %d", loaded.tree.Len(), filter.tree.Len())
+	}
+}
+
+func TestLoadDictionaryInvalid(t *testing.T) {
+	var buf bytes.Buffer
+	if err := NewFilter().WriteDictionary(&buf); err != nil {
+		t.Fatal(err)
+	}
+	valid := buf.String()
+
+	invalid := []string{
+		"",
+		"word,profane\nfoo,1\n",
+		strings.Replace(valid, dictionaryMagic+"\x01", dictionaryMagic+"\x02", 1),
+		strings.Replace(valid, "profane", "profan3", 1),
+		valid[:len(valid)/2],
+		valid[:len(valid)-1],
+		valid + "\x00",
+	}
+	for i, data := range invalid {
+		if _, err := LoadDictionary(strings.NewReader(data)); err == nil {
+			t.Errorf("case %d: expected error", i)
+		}
+	}
+}
+
+func TestLoadDictionaryCorrupt(t *testing.T) {
+	// Corrupting the default dictionary must give an error (or a valid
+	// dictionary), never a panic
+	valid := wordlistDictionary
+	load := func(description, data string) {
+		defer func() {
+			if r := recover(); r != nil {
+				t.Errorf("%s: panicked: %v", description, r)
+			}
+		}()
+		LoadDictionary(strings.NewReader(data))
+	}
+
+	for i := 0; i < len(valid); i += len(valid)/100 + 1 {
+		load(fmt.Sprintf("truncated at %d", i), valid[:i])
+	}
+	random := rand.New(rand.NewSource(1))
+	for n := 0; n < 300; n++ {
+		data := []byte(valid)
+		i := random.Intn(len(data))
+		data[i] ^= byte(1 + random.Intn(255))
+		load(fmt.Sprintf("byte %d changed to %#x", i, data[i]), string(data))
+	}
+}
+
+func ExampleLoadDictionary() {
+	filter := NewFilter()
+	filter.AddWord("noob", 0, 0, 0, 1)
+
+	var buf bytes.Buffer
+	filter.WriteDictionary(&buf)
+	loaded, err := LoadDictionary(&buf)
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(loaded.Scan("noob").Is(Mean))
+	// Output: true
+}
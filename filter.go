@@ -0,0 +1,309 @@
+package moderation
+
+import (
+	"errors"
+	"fmt"
+	"github.com/finnbear/moderation/internal/radix"
+	"sync"
+	"sync/atomic"
+)
+
+// Filter is a profanity filter with its own dictionary and settings
+//
+// The package level functions (Scan, IsInappropriate, Censor) use a default
+// Filter, so a Filter only needs to be created to run differently configured
+// filters side by side.
+type Filter struct {
+	tree        radix.Tree
+	thresholds  [countableTypes]thresholds
+	aggregation Aggregation
+
+	// Whether the failure links of the tree are up to date (see automaton)
+	linked uint32
+	linkMu sync.Mutex
+}
+
+// NewFilter returns a Filter with the default dictionary
+func NewFilter() *Filter {
+	filter, err := decodeDictionary(wordlistDictionary)
+	if err != nil {
+		panic(err)
+	}
+	return filter
+}
+
+// newFilter returns a Filter with an empty dictionary
+func newFilter() *Filter {
+	filter := &Filter{tree: radix.New()}
+	for i := range filter.thresholds {
+		filter.thresholds[i] = defaultThresholds
+	}
+	return filter
+}
+
+// changed marks the dictionary as changed, such that the failure links of the
+// tree are rebuilt before the next scan
+func (filter *Filter) changed() {
+	atomic.StoreUint32(&filter.linked, 0)
+}
+
+// automaton returns the root of the tree, first building its failure links if
+// the dictionary changed since they were last built
+//
+// Building them once all words are added is much faster than after each word,
+// and is safe to do during concurrent scans.
+func (filter *Filter) automaton() *radix.Node {
+	if atomic.LoadUint32(&filter.linked) == 0 {
+		filter.linkMu.Lock()
+		if filter.linked == 0 {
+			filter.tree.Link()
+			atomic.StoreUint32(&filter.linked, 1)
+		}
+		filter.linkMu.Unlock()
+	}
+	return filter.tree.Root()
+}
+
+// SetThresholds sets the minimum level of each of the given types (e.g.
+// Profane|Sexual) that is considered Mild, Moderate, and Severe, by default 1,
+// 2, and 3
+//
+// Like WithThresholds, which may override them for a single call,
+// SetThresholds panics unless 0 < mild <= moderate <= severe. SetThresholds
+// must not be called concurrently with any other method of the same Filter.
+func (filter *Filter) SetThresholds(types Type, mild, moderate, severe int) {
+	t := newThresholds(mild, moderate, severe)
+	for i := range filter.thresholds {
+		if types&(0b111<<(i*3)) != 0 {
+			filter.thresholds[i] = t
+		}
+	}
+}
+
+// SetAggregation sets how the levels of the words in a text are combined, by
+// default AggregateSum
+//
+// SetAggregation must not be called concurrently with any other method of the
+// same Filter.
+func (filter *Filter) SetAggregation(aggregation Aggregation) {
+	filter.aggregation = aggregation
+}
+
+// AddWord adds a word to the dictionary with the given level of each
+// countable type, in the order Profane, Offensive, Sexual, Mean, Violent,
+// SelfHarm, Distress, and then any registered categories in the order they
+// were registered (any that are omitted are zero), or replaces the levels of a
+// word that is already present
+//
+// Levels are summed over all words in the text, and a sum of 1, 2, or 3+ is
+// Mild, Moderate, or Severe respectively. Negative levels may be used to
+// cancel out false positives (e.g. "assassin" cancels out the "ass"'s).
+// Short words (3 letters, or 4 starting with 's') are only matched at the
+// start of a word.
+//
+// The word may only contain the letters a-z (in either case). Use AddPhrase
+// to add a phrase of several words. AddWord must not be called concurrently
+// with any other method of the same Filter.
+func (filter *Filter) AddWord(word string, levels ...int8) error {
+	word, err := normalizeWord(word)
+	if err != nil {
+		return err
+	}
+	if len(levels) > countableTypes {
+		return fmt.Errorf("moderation: %d levels given, but there are only %d countable types", len(levels), countableTypes)
+	}
+	var data radix.Levels
+	copy(data[:], levels)
+	filter.tree.Add(word, data)
+	filter.changed()
+	return nil
+}
+
+// AddPhrase is like AddWord, but the phrase may consist of several words
+// separated by separators like spaces (e.g. "go die")
+//
+// Unlike a word, which may be matched inside of other words, a phrase is only
+// matched if it starts and ends at a word boundary, and each of its separators
+// is matched by one or more separators or other characters that can't be part
+// of a word. For example, "go die" matches "go die", "go-die", and "go die!",
+// but not "godie", "ego die", or "go diet". The phrase, with single spaces
+// between its words, may be at most 25 characters long.
+func (filter *Filter) AddPhrase(phrase string, levels ...int8) error {
+	phrase, err := normalizePhrase(phrase)
+	if err != nil {
+		return err
+	}
+	if len(levels) > countableTypes {
+		return fmt.Errorf("moderation: %d levels given, but there are only %d countable types", len(levels), countableTypes)
+	}
+	var data radix.Levels
+	copy(data[:], levels)
+	filter.tree.Add(phrase, data)
+	filter.changed()
+	return nil
+}
+
+// SetWordLevel sets the level of a word for each countable type in types
+// (e.g. a Type returned by RegisterCategory), adding the word with zero
+// levels for the other types if it is not already present
+//
+// The word may only contain the letters a-z (in either case). SetWordLevel
+// must not be called concurrently with any other method of the same Filter.
+func (filter *Filter) SetWordLevel(word string, types Type, level int8) error {
+	word, err := normalizeWord(word)
+	if err != nil {
+		return err
+	}
+	data := filter.tree.Get(word)
+	for i := range data {
+		if types&(0b111<<(i*3)) != 0 {
+			data[i] = level
+		}
+	}
+	filter.tree.Add(word, data)
+	filter.changed()
+	return nil
+}
+
+// RemoveWord removes a word from the dictionary, returning whether it was
+// present
+//
+// RemoveWord must not be called concurrently with any other method of the
+// same Filter.
+func (filter *Filter) RemoveWord(word string) bool {
+	word, err := normalizeWord(word)
+	if err != nil {
+		return false
+	}
+	return filter.tree.Remove(word)
+}
+
+// RemovePhrase is like RemoveWord, but for a phrase added with AddPhrase
+func (filter *Filter) RemovePhrase(phrase string) bool {
+	phrase, err := normalizePhrase(phrase)
+	if err != nil {
+		return false
+	}
+	return filter.tree.Remove(phrase)
+}
+
+// AllowWord exempts a word from detection, such that no dictionary word that
+// lies fully inside it is matched (e.g. allowing "Scunthorpe")
+//
+// The allowed word is matched the same way as dictionary words, including
+// replacements like "5cunth0rpe", and a masked character like "Sc*nthorpe"
+// may stand for any of its letters.
+//
+// The word may only contain the letters a-z (in either case). AllowWord must
+// not be called concurrently with any other method of the same Filter.
+func (filter *Filter) AllowWord(word string) error {
+	word, err := normalizeWord(word)
+	if err != nil {
+		return err
+	}
+	filter.tree.Allow(word)
+	filter.changed()
+	return nil
+}
+
+// AllowPhrase is like AllowWord, but the phrase may consist of several words
+// separated by separators like spaces, which are matched at word boundaries
+// the same way as phrases added with AddPhrase (e.g. "hole in one" allows
+// "hole in one" and "hole-in-one", but not "holeinone")
+func (filter *Filter) AllowPhrase(phrase string) error {
+	phrase, err := normalizePhrase(phrase)
+	if err != nil {
+		return err
+	}
+	filter.tree.Allow(phrase)
+	filter.changed()
+	return nil
+}
+
+// isSeparator returns whether b may be skipped within a match, as false
+// positives may contain it
+func isSeparator(b byte) bool {
+	switch b {
+	case ' ', '~', '-', '_', '.', ',', '\n', '\r', '\t':
+		return true
+	}
+	return false
+}
+
+// normalizeWord lowercases word and checks that it may be added to the tree
+func normalizeWord(word string) (string, error) {
+	if len(word) == 0 {
+		return "", errors.New("moderation: empty word")
+	}
+	if len(word) > radix.LongestWord {
+		return "", fmt.Errorf("moderation: word %q is longer than %d letters", word, radix.LongestWord)
+	}
+	buf := []byte(word)
+	for i, b := range buf {
+		if b >= 'A' && b <= 'Z' {
+			buf[i] = b + 'a' - 'A'
+		} else if b < 'a' || b > 'z' {
+			return "", fmt.Errorf("moderation: word %q contains %q, but only a-z are allowed", word, b)
+		}
+	}
+	return string(buf), nil
+}
+
+// normalizePhrase is like normalizeWord, but for a phrase, which is returned
+// with its words separated by single spaces
+func normalizePhrase(phrase string) (string, error) {
+	var words []byte
+	start := -1
+	for i := 0; i <= len(phrase); i++ {
+		if i < len(phrase) && !isSeparator(phrase[i]) {
+			if start == -1 {
+				start = i
+			}
+			continue
+		}
+		if start == -1 {
+			continue
+		}
+		word, err := normalizeWord(phrase[start:i])
+		if err != nil {
+			return "", err
+		}
+		if len(words) > 0 {
+			words = append(words, ' ')
+		}
+		words = append(words, word...)
+		start = -1
+	}
+	if len(words) == 0 {
+		return "", errors.New("moderation: empty phrase")
+	}
+	if len(words) > radix.LongestWord {
+		return "", fmt.Errorf("moderation: phrase %q is longer than %d characters", phrase, radix.LongestWord)
+	}
+	return string(words), nil
+}
+
+// IsInappropriate returns whether a phrase contains enough inappropriate words
+// to meet or exceed InappropriateThreshold
+//
+// Equivalent to
+//  filter.Scan(text).Is(moderation.Inappropriate)
+//
+func (filter *Filter) IsInappropriate(text string) bool {
+	var state scanState
+	state.init(filter, newOptions(nil))
+	// Inappropriate includes neither Spam nor ContactInfo, although the
+	// length of the text is still needed for AggregateDensity
+	state.signals = filter.aggregation == AggregateDensity
+	state.scanString(text, 0)
+	return state.finish().Is(Inappropriate)
+}
+
+// Scan returns a bitmask of all types detected within given text, which can
+// be queried with the Is function
+func (filter *Filter) Scan(text string, opts ...Option) Type {
+	var state scanState
+	state.init(filter, newOptions(opts))
+	state.scanString(text, 0)
+	return state.finish()
+}
@@ -0,0 +1,196 @@
+package moderation
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestFilterMatchesPackage(t *testing.T) {
+	filter := NewFilter()
+	phrases := []string{"hello", "sh1t", "you're a dumbass", "HELLO THERE", "assassin", "βιτ⊂η"}
+	for _, phrase := range phrases {
+		if filter.Scan(phrase) != Scan(phrase) {
+			t.Errorf("phrase=\"%s\" filter=%b package=%b", phrase, filter.Scan(phrase), Scan(phrase))
+		}
+	}
+}
+
+func ExampleFilter() {
+	filter := NewFilter()
+	fmt.Println(filter.IsInappropriate("hello"), filter.IsInappropriate("sh1t"))
+	// Output: false true
+}
+
+func TestFilterAddRemoveWord(t *testing.T) {
+	filter := NewFilter()
+	other := NewFilter()
+
+	if filter.Scan("noobface").Is(Mean) {
+		t.Error("custom word detected before being added")
+	}
+	if err := filter.AddWord("NoobFace", 0, 0, 0, 2); err != nil {
+		t.Fatal(err)
+	}
+	if result := filter.Scan("what a n00bface"); !result.Is(Mean&Moderate) || result.Is(Mean&Severe) {
+		t.Errorf("custom word not detected with correct severity, got %b", result)
+	}
+	if other.Scan("noobface").Is(Mean) {
+		t.Error("custom word leaked to another filter")
+	}
+
+	if !filter.RemoveWord("noobface") {
+		t.Error("expected custom word to be removed")
+	}
+	if filter.RemoveWord("noobface") {
+		t.Error("expected custom word to already be removed")
+	}
+	if filter.Scan("noobface").Is(Mean) {
+		t.Error("custom word detected after being removed")
+	}
+
+	if !filter.RemoveWord("shit") || filter.Scan("shit").Is(Profane) {
+		t.Error("expected default word to be removed")
+	}
+	if !Scan("shit").Is(Profane) {
+		t.Error("removal leaked to package level functions")
+	}
+
+	if err := filter.AddWord("punchface", 0, 0, 0, 1, 2); err != nil {
+		t.Fatal(err)
+	}
+	if result := filter.Scan("punchface"); !result.Is(Violent&Moderate) || !result.Is(Mean) {
+		t.Errorf("custom word not detected with correct severity, got %b", result)
+	}
+	if filter.AddWord("toomany", make([]int8, countableTypes+1)...) == nil {
+		t.Error("expected error adding too many levels")
+	}
+
+	for _, invalid := range []string{"", "two words", "l33t", "abcdefghijklmnopqrstuvwxyz"} {
+		if filter.AddWord(invalid, 1, 0, 0, 0) == nil {
+			t.Errorf("expected error adding \"%s\"", invalid)
+		}
+	}
+}
+
+func ExampleFilter_AddWord() {
+	filter := NewFilter()
+	filter.AddWord("noob", 0, 0, 0, 1)
+	fmt.Println(filter.Scan("noob").Is(Mean), Scan("noob").Is(Mean))
+	// Output: true false
+}
+
+func TestFilterAddPhrase(t *testing.T) {
+	filter := NewFilter()
+	if err := filter.AddPhrase("Noob  Face", 0, 0, 0, 2); err != nil {
+		t.Fatal(err)
+	}
+	if !filter.Scan("what a n00b face").Is(Mean&Moderate) || !filter.Scan("noob-face").Is(Mean) {
+		t.Error("custom phrase not detected")
+	}
+	if filter.Scan("noobface").Is(Mean) || filter.Scan("a noob faces it").Is(Mean) {
+		t.Error("custom phrase detected without word boundaries")
+	}
+
+	// Phrases are completed by the end of the text, even when streamed
+	scanner := filter.NewScanner()
+	scanner.WriteString("you noob ")
+	scanner.WriteString("face")
+	if !scanner.Result().Is(Mean) {
+		t.Error("custom phrase not detected by scanner")
+	}
+
+	if !filter.RemovePhrase("noob face") || filter.Scan("noob face").Is(Mean) {
+		t.Error("expected custom phrase to be removed")
+	}
+
+	for _, invalid := range []string{"", " - ", "dick's sporting goods", "abcdefghijklm nopqrstuvwxyz"} {
+		if filter.AddPhrase(invalid, 1) == nil {
+			t.Errorf("expected error adding \"%s\"", invalid)
+		}
+	}
+}
+
+func ExampleFilter_AddPhrase() {
+	filter := NewFilter()
+	filter.AddPhrase("get rekt", 0, 0, 0, 1)
+	fmt.Println(filter.Scan("get rekt").Is(Mean), filter.Scan("getrekt").Is(Mean))
+	// Output: true false
+}
+
+func ExampleFilter_AllowPhrase() {
+	filter := NewFilter()
+	filter.AddWord("hole", 0, 0, 1)
+	filter.AllowPhrase("hole in one")
+	fmt.Println(filter.Scan("hole-in-one").Is(Sexual), filter.Scan("holeinone").Is(Sexual))
+	// Output: false true
+}
+
+func TestFilterAllow(t *testing.T) {
+	// Add the word explicitly, so the test doesn't depend on the dictionary
+	filter := NewFilter()
+	if err := filter.AddWord("cunt", 0, 2, 2); err != nil {
+		t.Fatal(err)
+	}
+	if !filter.Scan("Scunthorpe").Is(Any) {
+		t.Fatal("expected Scunthorpe to be matched before it is allowed")
+	}
+	if err := filter.AllowWord("Scunthorpe"); err != nil {
+		t.Fatal(err)
+	}
+	if err := filter.AllowPhrase("dick's sporting goods"); err == nil {
+		t.Error("expected error for phrase with apostrophe")
+	}
+	if err := filter.AllowPhrase("big dick energy drink"); err != nil {
+		t.Fatal(err)
+	}
+
+	type TestCase struct {
+		phrase        string
+		inappropriate bool
+	}
+	testCases := []TestCase{
+		{"I live in Scunthorpe", false},
+		{"I live in 5cunth0rpe", false},
+		{"I live in Sc*nthorpe", false},
+		{"I live in Scunth*rpe", false},
+		{"I live in Scunthorpe, you cunt", true},
+		{"would you like a big dick energy drink", false},
+		{"would you like a big-dick-energy-drink", false},
+		{"would you like a big dick", true},
+	}
+	for _, testCase := range testCases {
+		if result := filter.Scan(testCase.phrase); result.Is(Any) != testCase.inappropriate {
+			t.Errorf("phrase=\"%s\" result=%b expected inappropriate=%v", testCase.phrase, result, testCase.inappropriate)
+		}
+	}
+
+	// Masked characters can't be matched as dictionary words, only as part of
+	// an allowed word
+	if err := filter.AddWord("barf", 1, 0, 0, 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := filter.AllowWord("rebarfly"); err != nil {
+		t.Fatal(err)
+	}
+	if !filter.Scan("rebarf").Is(Profane) || filter.Scan("rebarfly").Is(Profane) || filter.Scan("r*barfly").Is(Profane) {
+		t.Error("masked allowed word not matched")
+	}
+
+	// Word boundaries in allowed phrases are meaningful
+	if err := filter.AddWord("hole", 0, 0, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := filter.AllowPhrase("hole in one"); err != nil {
+		t.Fatal(err)
+	}
+	if filter.Scan("I got a hole in one").Is(Sexual) || filter.Scan("hole-in-one!").Is(Sexual) {
+		t.Error("allowed phrase not matched")
+	}
+	if !filter.Scan("holeinone").Is(Sexual) || !filter.Scan("hole in ones").Is(Sexual) {
+		t.Error("allowed phrase matched without word boundaries")
+	}
+
+	if !Scan("I live in Scunthorpe").Is(Any) {
+		t.Error("allowed word leaked to package level functions")
+	}
+}
@@ -2,7 +2,10 @@ module github.com/finnbear/moderation/generator
 
 go 1.15
 
+replace github.com/finnbear/moderation => ../
+
 require (
+	github.com/finnbear/moderation v0.5.0
 	github.com/gertd/go-pluralize v0.1.7
 	github.com/schollz/progressbar/v3 v3.7.2
 )
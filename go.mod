@@ -2,7 +2,4 @@ module github.com/finnbear/moderation
 
 go 1.15
 
-require (
-	golang.org/x/exp v0.0.0-20210220032938-85be41e4509f
-	golang.org/x/text v0.3.4
-)
+require golang.org/x/text v0.3.4
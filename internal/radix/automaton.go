@@ -0,0 +1,73 @@
+package radix
+
+// Link builds the failure links of every node, which turn the tree into an
+// Aho-Corasick automaton that finds every word in a single pass over the text
+//
+// Link must be called after words are added or allowed, and before the tree is
+// used for matching. Words may be removed without calling Link again.
+func (tree *Tree) Link() {
+	// Failure links only ever point to shallower nodes, so they are built in
+	// breadth first order
+	queue := []*Node{tree.root}
+	tree.root.fail = nil
+	tree.root.output = nil
+	tree.root.bounds = tree.root.Boundary() != nil
+	for i := 0; i < len(queue); i++ {
+		node := queue[i]
+		for _, child := range node.children {
+			child.fail = tree.root
+			if node != tree.root {
+				child.fail = node.fail.Follow(child.letter)
+			}
+			child.output = child.fail.output
+			if child.fail.word || child.fail.allowed {
+				child.output = child.fail
+			}
+			child.bounds = child.Boundary() != nil || child.fail.bounds
+			queue = append(queue, child)
+		}
+	}
+}
+
+// Fail returns the node of the longest proper suffix of the node's path that
+// is also in the tree, or nil for the root
+func (node *Node) Fail() *Node {
+	return node.fail
+}
+
+// Output returns the node of the longest proper suffix of the node's path
+// that is a word or allowed, or nil if there is none
+//
+// Following Output from a node visits every word that ends with it.
+func (node *Node) Output() *Node {
+	return node.output
+}
+
+// Outputs returns whether the node or any suffix of its path is a word or
+// allowed, which is rare enough to check before following Output
+func (node *Node) Outputs() bool {
+	return node.word || node.allowed || node.output != nil
+}
+
+// Bounds returns whether the node or any suffix of its path is followed by a
+// word boundary in the tree, so that following one (see Follow) doesn't go
+// back to the root
+func (node *Node) Bounds() bool {
+	return node.bounds
+}
+
+// Follow returns the node that the automaton moves to from the node on the
+// given letter, or a space for a word boundary, which is the longest suffix of
+// the node's path plus the letter that is in the tree, or the root if there
+// is none
+func (node *Node) Follow(next byte) *Node {
+	i := index(next)
+	for current := node; ; current = current.fail {
+		if child := current.at(i); child != nil {
+			return child
+		}
+		if current.fail == nil {
+			return current
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package radix
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/bits"
+)
+
+// The encoding of a tree is the number of nodes as a uvarint, followed by
+// each node in preorder, with children in the order of their letters (a-z,
+// then the word boundary). Each node is a byte of flags, followed by its
+// levels if it is a word, followed by either the index of its only child, or
+// a 4 byte little endian bitmap of its children if it has more than one.
+const (
+	flagWord = 1 << iota
+	flagAllowed
+	flagChildren // more than one child
+	flagChild    // exactly one child
+)
+
+// Encode appends the encoding of the tree to buf, including the first
+// levels levels of each word, and returns the extended buffer
+func (tree *Tree) Encode(buf []byte, levels int) []byte {
+	var count [binary.MaxVarintLen64]byte
+	buf = append(buf, count[:binary.PutUvarint(count[:], uint64(tree.root.count()))]...)
+	return tree.root.encode(buf, levels)
+}
+
+// count returns the number of nodes in the subtree rooted at the node
+func (node *Node) count() int {
+	count := 1
+	for _, child := range node.children {
+		count += child.count()
+	}
+	return count
+}
+
+func (node *Node) encode(buf []byte, levels int) []byte {
+	children := len(node.children)
+
+	var flags byte
+	if node.word {
+		flags |= flagWord
+	}
+	if node.allowed {
+		flags |= flagAllowed
+	}
+	if children == 1 {
+		flags |= flagChild
+	} else if children > 1 {
+		flags |= flagChildren
+	}
+	buf = append(buf, flags)
+
+	if node.word {
+		for _, level := range node.data[:levels] {
+			buf = append(buf, byte(level))
+		}
+	}
+
+	if children == 1 {
+		buf = append(buf, byte(bits.TrailingZeros32(node.bitmap)))
+	} else if children > 1 {
+		bitmap := node.bitmap
+		buf = append(buf, byte(bitmap), byte(bitmap>>8), byte(bitmap>>16), byte(bitmap>>24))
+	}
+	for _, child := range node.children {
+		buf = child.encode(buf, levels)
+	}
+	return buf
+}
+
+var errTruncated = errors.New("radix: encoded tree is truncated")
+
+// Decode decodes a tree encoded by Encode, where columns is the index in
+// Levels of each level that was encoded, and returns the rest of data
+func Decode(data string, columns []int) (Tree, string, error) {
+	for _, column := range columns {
+		if column < 0 || column >= MaxLevels {
+			return Tree{}, data, fmt.Errorf("radix: invalid level index %d", column)
+		}
+	}
+
+	count, n := uvarint(data)
+	if n <= 0 {
+		return Tree{}, data, errTruncated
+	}
+	// Every node takes at least one byte
+	if count == 0 || count > uint64(len(data)-n) {
+		return Tree{}, data, fmt.Errorf("radix: invalid node count %d", count)
+	}
+
+	decoder := decoder{
+		data:     data,
+		pos:      n,
+		nodes:    make([]Node, count),
+		children: make([]*Node, count-1),
+		columns:  columns,
+	}
+	tree := Tree{root: decoder.node()}
+	if err := decoder.decode(tree.root); err != nil {
+		return Tree{}, data, err
+	}
+	if decoder.next != len(decoder.nodes) {
+		return Tree{}, data, fmt.Errorf("radix: expected %d nodes, got %d", len(decoder.nodes), decoder.next)
+	}
+	tree.length = decoder.words
+	return tree, data[decoder.pos:], nil
+}
+
+// uvarint is like binary.Uvarint, but for a string
+func uvarint(data string) (uint64, int) {
+	var x uint64
+	var s uint
+	for i := 0; i < len(data) && i < binary.MaxVarintLen64; i++ {
+		b := data[i]
+		if b < 0x80 {
+			return x | uint64(b)<<s, i + 1
+		}
+		x |= uint64(b&0x7f) << s
+		s += 7
+	}
+	return 0, 0
+}
+
+// decoder decodes the nodes of a tree into a single allocation, and their
+// children into another, while the levels of words are allocated in chunks
+type decoder struct {
+	data     string
+	pos      int
+	nodes    []Node
+	next     int
+	children []*Node
+	levels   []Levels
+	words    int
+	columns  []int
+}
+
+// levelsChunk is the number of words whose levels are allocated at once
+const levelsChunk = 256
+
+// node returns the next unused node, or nil if there are none left
+func (decoder *decoder) node() *Node {
+	if decoder.next == len(decoder.nodes) {
+		return nil
+	}
+	node := &decoder.nodes[decoder.next]
+	decoder.next++
+	return node
+}
+
+// decode decodes the node and its descendants, whose parent, start, letter,
+// and depth are already set
+func (decoder *decoder) decode(node *Node) error {
+	if decoder.pos >= len(decoder.data) {
+		return errTruncated
+	}
+	flags := decoder.data[decoder.pos]
+	decoder.pos++
+	if flags&^(flagWord|flagAllowed|flagChildren|flagChild) != 0 || flags&(flagChildren|flagChild) == flagChildren|flagChild {
+		return fmt.Errorf("radix: invalid flags %#x", flags)
+	}
+
+	if flags&flagWord != 0 {
+		if decoder.pos+len(decoder.columns) > len(decoder.data) {
+			return errTruncated
+		}
+		if len(decoder.levels) == 0 {
+			decoder.levels = make([]Levels, levelsChunk)
+		}
+		node.data = &decoder.levels[0]
+		decoder.levels = decoder.levels[1:]
+		for _, column := range decoder.columns {
+			node.data[column] = int8(decoder.data[decoder.pos])
+			decoder.pos++
+		}
+		node.word = true
+		decoder.words++
+	}
+	if flags&flagAllowed != 0 {
+		node.allowed = true
+		node.allows = true
+	}
+
+	var bitmap uint32
+	switch {
+	case flags&flagChild != 0:
+		if decoder.pos >= len(decoder.data) {
+			return errTruncated
+		}
+		bitmap = 1 << decoder.data[decoder.pos]
+		decoder.pos++
+		if decoder.data[decoder.pos-1] >= alphabet {
+			return fmt.Errorf("radix: invalid child %d", decoder.data[decoder.pos-1])
+		}
+	case flags&flagChildren != 0:
+		if decoder.pos+4 > len(decoder.data) {
+			return errTruncated
+		}
+		data := decoder.data[decoder.pos : decoder.pos+4]
+		bitmap = uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16 | uint32(data[3])<<24
+		decoder.pos += 4
+		if bitmap&(bitmap-1) == 0 || bitmap>>alphabet != 0 {
+			return fmt.Errorf("radix: invalid children %#x", bitmap)
+		}
+	default:
+		return nil
+	}
+	if node.depth > LongestWord {
+		return errors.New("radix: encoded word is too long")
+	}
+
+	// Every node other than the root is a child, so there is a slot for each
+	// child unless there are more nodes than expected
+	children := bits.OnesCount32(bitmap)
+	if children > len(decoder.children) {
+		return errors.New("radix: more nodes than expected")
+	}
+	node.children = decoder.children[:children:children]
+	decoder.children = decoder.children[children:]
+	node.bitmap = bitmap
+
+	for j := range node.children {
+		i := bits.TrailingZeros32(bitmap)
+		bitmap &= bitmap - 1
+
+		child := decoder.node()
+		child.parent = node
+		child.depth = node.depth + 1
+		child.letter = letter(i)
+		child.start = child.letter
+		if node.parent != nil {
+			child.start = node.start
+		}
+		node.children[j] = child
+		if err := decoder.decode(child); err != nil {
+			return err
+		}
+		if child.allows {
+			node.allows = true
+		}
+	}
+	return nil
+}
+
+// letter returns the letter of a child, given its index
+func letter(index int) byte {
+	if index == boundary {
+		return ' '
+	}
+	return byte(index) + chOffset
+}
@@ -1,12 +1,146 @@
 package radix
 
+// maxDepth is the depth of the deepest node, that of the longest word plus a
+// word boundary (see Node.Boundary)
+const maxDepth = LongestWord + 1
+
+// MaxSpan is the number of bytes that a word may span, from the index of its
+// first letter to that of its last, plus one
+//
+// Each letter of a match only remembers the lowest 16 bits of its index (see
+// Match.Start), which keeps matches small enough to copy cheaply. Matches that
+// may span more must be shortened first (e.g. by following Node.Fail).
+const MaxSpan = 1 << 16
+
+// Match is a path through the Aho-Corasick automaton of a tree, where Node is
+// the deepest node whose path is a suffix of the letters consumed so far
+//
+// Every shorter suffix that is also in the tree is implied by the failure
+// links of Node (see Node.Fail), so a single Match stands for every word that
+// may start at any of its letters. Each letter remembers where it started,
+// from which the start of each of those words is found.
 type Match struct {
-	Node     *Node
-	Length   int  // how many matchable characters contributed
-	Replaced bool // whether a replacement character contributed
-	Separate bool // false if the match came after another caracter (no space/separation) and contains no skipped characters
+	Node      *Node
+	AllowOnly bool // whether a wildcard contributed, so only allowed words may be matched
+
+	last     int              // index of the last letter
+	starts   [maxDepth]uint16 // index of each letter of the path of Node (truncated), from the first
+	separate uint32           // bits of letters that came after a separator, with no skipped characters since
+	bounded  uint32           // bits of letters that came at a word boundary
+	replaced uint32           // bits of letters that a replacement character (e.g. "1" or "*") stood for
+	masked   uint32           // bits of letters that came after a masking character (e.g. "*")
+	masking  bool             // whether a masking character came after the last letter
+}
+
+// The flags of the last letter consumed are in the lowest bit, those of the
+// one before it in the next bit, and so on, but only for the letters of the
+// path of Node, so that matches are compared without masking them
+
+// Same returns whether the matches are at the same node, and the letters of
+// its path have the same flags, so they will match the same words from now on
+// (although possibly starting at different characters)
+func (match *Match) Same(other *Match) bool {
+	return match.Node == other.Node && match.AllowOnly == other.AllowOnly &&
+		match.separate == other.separate && match.bounded == other.bounded &&
+		match.replaced == other.replaced && match.masked == other.masked &&
+		match.masking == other.masking
+}
+
+// Consume advances the match to node by consuming a letter at index start,
+// which came after a separator (or at the start of the text) if separate, and
+// that a replacement character stood for if replaced
+func (match *Match) Consume(node *Node, start int, separate, replaced bool) {
+	match.record(match, node, start)
+	match.advance(node, separate, replaced)
+}
+
+// record remembers the indices of the letters of the path of node, those of
+// from followed by a letter at index start, as node's path is a suffix of
+// them (from may be match itself)
+func (match *Match) record(from *Match, node *Node, start int) {
+	depth, kept := from.Node.Depth(), node.Depth()-1
+	if kept < 0 {
+		// Back at the root, so there is nothing to remember
+		return
+	}
+	copy(match.starts[:kept], from.starts[depth-kept:depth])
+	match.starts[kept] = uint16(start)
+	match.last = start
+}
+
+// advance moves the match to node, updating the flags of its letters, as if
+// a letter was consumed (see Consume)
+func (match *Match) advance(node *Node, separate, replaced bool) {
+	recent := uint32(1)<<uint(node.Depth()) - 1
+	match.separate = match.separate << 1 & recent
+	match.bounded = match.bounded << 1 & recent
+	match.replaced = match.replaced << 1 & recent
+	match.masked = match.masked << 1 & recent
+	if separate {
+		match.separate |= 1
+		match.bounded |= 1
+	}
+	if replaced {
+		match.replaced |= 1
+	}
+	if match.masking {
+		match.masked |= 1
+		match.masking = false
+	}
+	match.Node = node
+}
+
+// Fail shortens the match to the longest proper suffix of its path that is in
+// the tree (see Node.Fail), which must not be the root
+func (match *Match) Fail() {
+	depth := match.Node.Depth()
+	match.Node = match.Node.Fail()
+	kept := match.Node.Depth()
+	copy(match.starts[:kept], match.starts[depth-kept:depth])
+	recent := uint32(1)<<uint(kept) - 1
+	match.separate &= recent
+	match.bounded &= recent
+	match.replaced &= recent
+	match.masked &= recent
+}
+
+// Skip marks every letter consumed so far as no longer separate, because a
+// character was skipped after it, which was a masking character if masking
+func (match *Match) Skip(masking bool) {
+	match.separate = 0
+	match.masking = match.masking || masking
+}
+
+// Start returns the index of the first character of the word of the given
+// depth that ends at the last letter consumed
+func (match *Match) Start(depth int) int {
+	// Only the distance from the last letter is needed, which is less than
+	// MaxSpan for any word
+	start := match.starts[match.Node.Depth()-depth]
+	return match.last - int(uint16(match.last)-start)
+}
+
+// Separate returns whether the word of the given depth that ends at the last
+// letter consumed came after a separator, and contains no skipped characters
+func (match *Match) Separate(depth int) bool {
+	return match.separate&(1<<uint(depth-1)) != 0
+}
+
+// Replaced returns whether a replacement character stood for any letter of
+// the word of the given depth that ends at the last letter consumed, or a
+// masking character was skipped between any of its letters
+func (match *Match) Replaced(depth int) bool {
+	return match.replaced&(1<<uint(depth)-1) != 0 || match.Masked(depth)
+}
+
+// Masked returns whether a masking character was skipped between any of the
+// letters of the word of the given depth that ends at the last letter consumed
+func (match *Match) Masked(depth int) bool {
+	return match.masked&(1<<uint(depth-1)-1) != 0
 }
 
-func (match Match) EqualsExceptLength(other Match) bool {
-	return match.Node == other.Node && match.Replaced == other.Replaced && match.Separate == other.Separate
+// Bounded returns whether the word of the given depth that ends at the last
+// letter consumed started at a word boundary, as phrases must
+func (match *Match) Bounded(depth int) bool {
+	return match.bounded&(1<<uint(depth-1)) != 0
 }
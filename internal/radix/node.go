@@ -1,20 +1,66 @@
 package radix
 
+import (
+	"math/bits"
+	"strings"
+)
+
 type Node struct {
-	children    [alphabet]*Node
-	word        bool
-	hasChildren bool
-	start       byte // starting character (not offset)
-	depth       byte
-	data        uint32
+	// Only the children that are present are stored, in the order of their
+	// indices, which are the set bits of bitmap
+	children []*Node
+	bitmap   uint32
+
+	parent  *Node
+	fail    *Node   // longest proper suffix in the tree, or nil for the root
+	output  *Node   // longest proper suffix that is a word or allowed, if any
+	data    *Levels // only allocated for words, as most nodes aren't
+	word    bool
+	allowed bool // whether the word is allowed (exempt from matching)
+	allows  bool // whether this node or any descendant is allowed
+	bounds  bool // whether this node or any suffix is followed by a word boundary
+	start   byte // starting character (not offset)
+	letter  byte // last character (not offset)
+	depth   byte
 }
 
 func (node *Node) Word() bool {
 	return node.word
 }
 
-func (node *Node) Data() uint32 {
-	return node.data
+// Allowed returns whether the node ends an allowed word
+func (node *Node) Allowed() bool {
+	return node.allowed
+}
+
+// Allows returns whether the node or any of its descendants ends an allowed
+// word
+func (node *Node) Allows() bool {
+	return node.allows
+}
+
+// Children returns the children of the node that follow a letter, in
+// alphabetical order
+func (node *Node) Children() []*Node {
+	if node.bitmap&(1<<boundary) != 0 {
+		return node.children[:len(node.children)-1]
+	}
+	return node.children
+}
+
+// Boundary returns the child of the node that follows a word boundary, which
+// is only present in phrases
+func (node *Node) Boundary() *Node {
+	return node.at(boundary)
+}
+
+// Data returns the levels of the word that ends at the node, which are zero if
+// it isn't a word
+func (node *Node) Data() Levels {
+	if node.data == nil {
+		return Levels{}
+	}
+	return *node.data
 }
 
 func (node *Node) Depth() int {
@@ -22,23 +68,62 @@ func (node *Node) Depth() int {
 }
 
 func (node *Node) Next(next byte) *Node {
-	return node.children[next-chOffset]
+	return node.at(int(next - chOffset))
+}
+
+// child is like Next, but also accepts a space for a word boundary
+func (node *Node) child(next byte) *Node {
+	return node.at(index(next))
+}
+
+// at returns the child with the given index, or nil if there is none
+func (node *Node) at(index int) *Node {
+	bit := uint32(1) << uint(index)
+	if node.bitmap&bit == 0 {
+		return nil
+	}
+	return node.children[bits.OnesCount32(node.bitmap&(bit-1))]
+}
+
+// setChild adds a child with the given index, which must not already exist
+func (node *Node) setChild(index int, child *Node) {
+	bit := uint32(1) << uint(index)
+	position := bits.OnesCount32(node.bitmap & (bit - 1))
+	node.children = append(node.children, nil)
+	copy(node.children[position+1:], node.children[position:])
+	node.children[position] = child
+	node.bitmap |= bit
+}
+
+// index returns the index of a child, given a letter or a space for a word
+// boundary
+func index(next byte) int {
+	if next == ' ' {
+		return boundary
+	}
+	return int(next - chOffset)
 }
 
 func (node *Node) Start() byte {
 	return node.start
 }
 
-func (node *Node) traverse(word *[longestWord]byte, end int, callback func(string, uint32)) {
+// String returns the characters leading to the node, without the word
+// boundary at the end of a phrase
+func (node *Node) String() string {
+	buf := make([]byte, node.depth)
+	for n := node; n.parent != nil; n = n.parent {
+		buf[n.depth-1] = n.letter
+	}
+	return strings.TrimSuffix(string(buf), " ")
+}
+
+func (node *Node) traverse(word *[LongestWord + 1]byte, end int, callback func(string, Levels)) {
 	if node.word {
-		callback(string(word[0:end]), node.data)
+		callback(strings.TrimSuffix(string(word[0:end]), " "), node.Data())
 	}
-	if node.hasChildren {
-		for i, n := range node.children {
-			if n != nil {
-				word[end] = byte(i) + chOffset
-				n.traverse(word, end+1, callback)
-			}
-		}
+	for _, n := range node.children {
+		word[end] = n.letter
+		n.traverse(word, end+1, callback)
 	}
 }
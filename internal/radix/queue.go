@@ -1,57 +1,140 @@
 package radix
 
+// QueueSize is the number of matches a Queue holds
+//
+// For correctness, some characters turn into several matches, but no text
+// that isn't contrived to do so comes close (the default dictionary needs
+// fewer than 16). For performance, should be a power of 2 so that modulo
+// division is faster.
+const QueueSize = 32
+
+// Queue is a ring buffer of matches with a fixed size
+//
+// Once the queue is full, any match that is appended is discarded, rather than
+// overwriting one that is already queued. This bounds the work done for each
+// character of pathological text (e.g. long runs of characters that each
+// stand for several letters), at the cost of missing some of the words that
+// the discarded matches would have found.
 type Queue struct {
-	// For correctness, at least longestWord * 2 because some characters turn into 2 matches
-	// In reality, there are no profanities that are missed by reducing this to a lower level
-	// For performance, should be a power of 2 so that modulo division is faster
-	Storage    [32]Match
+	Storage [QueueSize]Match
+
+	// Where a match is built if the queue is full (see back)
+	spare Match
 
 	// Length of abstract queue
-	length     int
+	length int
 
 	// Indices of next read/write
-	readIndex  int
-	writeIndex int
+	readIndex  uint
+	writeIndex uint
+
+	// Number of matches discarded because the queue was full
+	discarded int
 }
 
 const debug = false
 
-// appends to back
-func (queue *Queue) Append(match Match) {
-	queue.length++
+// appends to back, returning false if the queue is full, in which case the
+// match is discarded
+func (queue *Queue) Append(match Match) bool {
+	if queue.length == len(queue.Storage) {
+		queue.discarded++
+		return false
+	}
+	*queue.back() = match
+	queue.push()
+	return true
+}
 
-	if debug && queue.length > len(queue.Storage) {
-		panic("queue too small")
+// appends to back if queue does not already contain the same match, ignoring
+// the first skip matches (e.g. those that are yet to be removed and advanced)
+func (queue *Queue) AppendUnique(match *Match, skip int) {
+	if queue.unique(match, skip) {
+		*queue.back() = *match
+		queue.push()
 	}
+}
 
-	queue.Storage[queue.writeIndex] = match
-	queue.writeIndex = (queue.writeIndex + 1) % len(queue.Storage)
+// back returns where the next match is built in place, so that it needn't be
+// copied, before it is appended
+//
+// If the queue is full, it is built in a spare match, which is discarded.
+func (queue *Queue) back() *Match {
+	if queue.length == len(queue.Storage) {
+		return &queue.spare
+	}
+	return &queue.Storage[queue.writeIndex]
 }
 
-// appends to back if queue does not already contain node
-func (queue *Queue) AppendUnique(match Match) {
-	for i := 0; i < queue.length; i++ {
-		idx := (queue.readIndex + i) % len(queue.Storage)
-		if queue.Storage[idx].EqualsExceptLength(match) {
-			// Not unique so return early
-			return
-		}
+// Advance builds the match that match advances to by consuming a letter at
+// index start (see Match.Consume) in place, and returns it, along with
+// whether it is unique (see AppendUnique), in which case it may be appended
+// by Commit
+//
+// If it is not unique, and node has no outputs, it returns nil instead, so
+// that the indices of the letters of match needn't be copied.
+func (queue *Queue) Advance(match *Match, node *Node, start int, separate, replaced bool, skip int) (*Match, bool) {
+	advanced := queue.back()
+	advanced.AllowOnly = match.AllowOnly
+	advanced.separate = match.separate
+	advanced.bounded = match.bounded
+	advanced.replaced = match.replaced
+	advanced.masked = match.masked
+	advanced.masking = match.masking
+	advanced.advance(node, separate, replaced)
+	unique := queue.unique(advanced, skip)
+	if !unique && !node.Outputs() {
+		return nil, false
+	}
+	advanced.record(match, node, start)
+	return advanced, unique
+}
+
+// Commit appends the match that was last built by Advance
+func (queue *Queue) Commit() {
+	queue.push()
+}
+
+// push appends the match built at back, unless the queue is full
+func (queue *Queue) push() {
+	if queue.length == len(queue.Storage) {
+		queue.discarded++
+		return
 	}
+	queue.length++
+	queue.writeIndex = (queue.writeIndex + 1) % QueueSize
+}
 
-	queue.Append(match)
+// unique returns whether the queue doesn't contain the same match, ignoring
+// the first skip matches
+func (queue *Queue) unique(match *Match, skip int) bool {
+	for i := skip; i < queue.length; i++ {
+		idx := (queue.readIndex + uint(i)) % QueueSize
+		if queue.Storage[idx].Same(match) {
+			return false
+		}
+	}
+	return true
 }
 
-// removes from front
-func (queue *Queue) Remove() (match Match) {
+// removes from front, returning the match in place, which remains valid until
+// the queue is next full (its storage is the last to be appended to)
+func (queue *Queue) Remove() *Match {
 	queue.length--
 
 	if debug && queue.length < 0 {
 		panic("queue out of range")
 	}
 
-	match = queue.Storage[queue.readIndex]
-	queue.readIndex = (queue.readIndex + 1) % len(queue.Storage)
-	return
+	match := &queue.Storage[queue.readIndex]
+	queue.readIndex = (queue.readIndex + 1) % QueueSize
+	return match
+}
+
+// returns the match i places from the front, which may be modified in place,
+// and remains valid until it is removed
+func (queue *Queue) At(i int) *Match {
+	return &queue.Storage[(queue.readIndex+uint(i))%QueueSize]
 }
 
 func (queue *Queue) Clear() {
@@ -63,3 +146,9 @@ func (queue *Queue) Clear() {
 func (queue *Queue) Len() int {
 	return queue.length
 }
+
+// Discarded returns the number of matches that were discarded because the
+// queue was full, since it was created
+func (queue *Queue) Discarded() int {
+	return queue.discarded
+}
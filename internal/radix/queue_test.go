@@ -0,0 +1,43 @@
+package radix
+
+import "testing"
+
+func TestQueueFull(t *testing.T) {
+	var nodes [QueueSize + 1]Node
+	var queue Queue
+
+	// Wrap around the end of the storage
+	for i := 0; i < QueueSize/2; i++ {
+		queue.Append(Match{Node: &nodes[i]})
+		queue.Remove()
+	}
+
+	for i := 0; i < QueueSize; i++ {
+		if !queue.Append(Match{Node: &nodes[i]}) {
+			t.Fatalf("match %d discarded", i)
+		}
+	}
+	if queue.Append(Match{Node: &nodes[QueueSize]}) {
+		t.Error("expected match to be discarded")
+	}
+	queue.AppendUnique(&Match{Node: &nodes[QueueSize]}, 0)
+	if queue.Len() != QueueSize || queue.Discarded() != 2 {
+		t.Errorf("len=%d discarded=%d", queue.Len(), queue.Discarded())
+	}
+
+	// Queued matches are never overwritten
+	for i := 0; i < QueueSize; i++ {
+		if queue.At(i).Node != &nodes[i] {
+			t.Fatalf("match %d overwritten", i)
+		}
+	}
+
+	// Once a match is removed, there is room for one more
+	queue.Remove()
+	if !queue.Append(Match{Node: &nodes[QueueSize]}) {
+		t.Error("expected match to be appended")
+	}
+	if queue.At(QueueSize-1).Node != &nodes[QueueSize] || queue.At(0).Node != &nodes[1] {
+		t.Error("wrong order after wrapping")
+	}
+}
@@ -1,12 +1,26 @@
 package radix
 
+import "strings"
+
 const (
-	alphabet    = 26
-	longestWord = 25
+	// The letters a-z, followed by a word boundary, which is a space in a
+	// phrase
+	alphabet = 27
+	boundary = alphabet - 1
+
+	// LongestWord is the maximum length of a word or phrase that can be
+	// added
+	LongestWord = 25
 	chMax       = 1 + byte('z') - byte('a')
 	chOffset    = byte('a')
+
+	// MaxLevels is the number of levels each word has
+	MaxLevels = 16
 )
 
+// Levels are the levels of a word, one per countable type
+type Levels [MaxLevels]int8
+
 type Tree struct {
 	root   *Node
 	length int
@@ -20,28 +34,78 @@ func (tree *Tree) Root() *Node {
 	return tree.root
 }
 
-func (tree *Tree) Add(word string, data uint32) {
+// Add adds a word, or a phrase of words separated by single spaces, which
+// only matches at word boundaries
+func (tree *Tree) Add(word string, data Levels) {
+	current := tree.insert(key(word))
+	if current.data == nil {
+		current.data = new(Levels)
+	}
+	*current.data = data
+	if !current.word {
+		current.word = true
+		tree.length++
+	}
+}
+
+// Allow marks word as allowed, adding it if necessary without changing its
+// data
+func (tree *Tree) Allow(word string) {
+	word = key(word)
+	tree.insert(word).allowed = true
+
+	// Mark the path so that matches know it leads to an allowed word
 	current := tree.root
+	current.allows = true
 	for i := 0; i < len(word); i++ {
-		next := current.Next(word[i])
+		current = current.child(word[i])
+		current.allows = true
+	}
+}
+
+// key returns the path to word in the tree, which for phrases ends in a word
+// boundary
+func key(word string) string {
+	if strings.IndexByte(word, ' ') != -1 {
+		return word + " "
+	}
+	return word
+}
+
+// insert returns the node for a key, creating it and its parents if
+// necessary
+func (tree *Tree) insert(word string) *Node {
+	current := tree.root
+	for i := 0; i < len(word); i++ {
+		next := current.child(word[i])
 		if next == nil {
-			next = &Node{depth: byte(i + 1), start: word[0]}
-			current.children[word[i]-chOffset] = next
-			current.hasChildren = true
+			next = &Node{parent: current, depth: byte(i + 1), start: word[0], letter: word[i]}
+			current.setChild(index(word[i]), next)
 		}
 		current = next
 	}
-	current.data = data
-	if !current.word {
-		current.word = true
-		tree.length++
+	return current
+}
+
+// Remove unmarks word, returning whether it was present
+//
+// Nodes are left in place so that existing matches remain valid
+func (tree *Tree) Remove(word string) (removed bool) {
+	node := tree.get(word)
+	if node == nil || !node.word {
+		return false
 	}
+	node.word = false
+	node.data = nil
+	tree.length--
+	return true
 }
 
 func (tree *Tree) get(word string) (node *Node) {
+	word = key(word)
 	current := tree.root
 	for i := 0; i < len(word); i++ {
-		current = current.Next(word[i])
+		current = current.child(word[i])
 		if current == nil {
 			return
 		}
@@ -49,10 +113,10 @@ func (tree *Tree) get(word string) (node *Node) {
 	return current
 }
 
-func (tree *Tree) Get(word string) (data uint32) {
+func (tree *Tree) Get(word string) (data Levels) {
 	node := tree.get(word)
 	if node != nil && node.word {
-		data = node.data
+		data = node.Data()
 	}
 	return
 }
@@ -63,8 +127,8 @@ func (tree *Tree) Contains(word string) (contains bool) {
 }
 
 // Preorder
-func (tree *Tree) Traverse(callback func(string, uint32)) {
-	tree.root.traverse(&[longestWord]byte{}, 0, callback)
+func (tree *Tree) Traverse(callback func(string, Levels)) {
+	tree.root.traverse(&[LongestWord + 1]byte{}, 0, callback)
 }
 
 func (tree *Tree) Len() (length int) {
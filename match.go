@@ -0,0 +1,79 @@
+package moderation
+
+import "sort"
+
+// Match is a dictionary word found in text
+type Match struct {
+	// Byte offsets of the match in the original text, which includes any
+	// accents, replacements, and skipped characters
+	Start, End int
+
+	// The matched part of the original text, equal to text[Start:End]
+	Text string
+
+	// The dictionary word that was matched (e.g. "shit" for "$#1t")
+	Word string
+
+	// The level the match contributes to each of Profane, Offensive, Sexual,
+	// Mean, Violent, SelfHarm, and Distress, which is negative for false
+	// positives (e.g. "assassin")
+	Levels [countableTypes]int
+
+	// Whether a replacement character (e.g. "1" for "i") or a masking
+	// character (e.g. "*") contributed
+	Replaced bool
+
+	// Whether the match came after a separator (e.g. space) and contains no
+	// skipped characters
+	Separate bool
+
+	// The result of Type, according to the thresholds of the scan
+	typ Type
+}
+
+// Type returns the severity of each type of the match, as if it were the
+// only match in the text, according to the thresholds of the filter that
+// found it (or those given by WithThresholds)
+func (match Match) Type() Type {
+	return match.typ
+}
+
+// ScanMatches returns every dictionary word found in text, in the order they
+// end
+//
+// Unlike Scan, it reports where each word is, which is useful for explaining
+// or highlighting a result. Summing the Levels of every match gives the
+// levels used to compute the result of Scan.
+func (filter *Filter) ScanMatches(text string) (matches []Match) {
+	var state scanState
+	state.init(filter, newOptions(nil))
+	state.onHit = func(hit hit) {
+		if match := hit.match(text, state.thresholds); match.Levels != [countableTypes]int{} {
+			matches = append(matches, match)
+		}
+	}
+	state.scanString(text, 0)
+	state.finish()
+
+	// Hits that might have been inside an allowed span are counted late
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].End < matches[j].End
+	})
+	return
+}
+
+// match converts a hit in text to a Match, whose type is found with the given
+// thresholds
+func (hit hit) match(text string, thresholds *[countableTypes]thresholds) Match {
+	match := Match{
+		Start:    hit.start,
+		End:      hit.end,
+		Text:     text[hit.start:hit.end],
+		Word:     hit.node.String(),
+		Levels:   hit.levels(),
+		Replaced: hit.replaced,
+		Separate: hit.separate,
+	}
+	match.typ = levelsType(match.Levels, thresholds)
+	return match
+}
@@ -0,0 +1,91 @@
+package moderation
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestScanMatches(t *testing.T) {
+	type TestCase struct {
+		phrase   string
+		text     string
+		word     string
+		replaced bool
+	}
+	testCases := []TestCase{
+		{"sh1t happens", "sh1t", "shit", true},
+		{"what the fučk", "fučk", "fuck", false},
+		{"what the f*u*c*k", "f*u*c*k", "fuck", true},
+		{"fu*ck", "fu*ck", "fuck", true},
+		{"*fuck*", "fuck", "fuck", false},
+		{"ÄšŚ", "ÄšŚ", "ass", false},
+		{"βιτ⊂η please", "βιτ⊂η", "bitch", true},
+		{"shhhhhiiiiter", "shhhhhiiiit", "shit", false},
+	}
+	for _, testCase := range testCases {
+		matches := ScanMatches(testCase.phrase)
+		found := false
+		for _, match := range matches {
+			if match.Text != testCase.phrase[match.Start:match.End] {
+				t.Errorf("phrase=\"%s\" match text \"%s\" does not match offsets", testCase.phrase, match.Text)
+			}
+			if match.Text == testCase.text && match.Word == testCase.word {
+				found = true
+				if match.Replaced != testCase.replaced {
+					t.Errorf("phrase=\"%s\" match \"%s\" expected replaced=%v", testCase.phrase, match.Text, testCase.replaced)
+				}
+			}
+		}
+		if !found {
+			t.Errorf("phrase=\"%s\" expected \"%s\" (%s) in %+v", testCase.phrase, testCase.text, testCase.word, matches)
+		}
+	}
+
+	// The levels of all matches should sum to the result of Scan
+	for _, phrase := range []string{"you're a dumbass", "assassin", "hello", "Scunthorpe"} {
+		var levels [countableTypes]int
+		for _, match := range ScanMatches(phrase) {
+			for i, level := range match.Levels {
+				levels[i] += level
+			}
+		}
+		if levelsType(levels, &defaultFilter().thresholds) != Scan(phrase)&^Spam {
+			t.Errorf("phrase=\"%s\" matches sum to %b but scan was %b", phrase, levelsType(levels, &defaultFilter().thresholds), Scan(phrase))
+		}
+	}
+}
+
+func TestMatchType(t *testing.T) {
+	// The type of a match uses the thresholds of the filter that found it
+	filter := newFilter()
+	filter.AddWord("darn", 1)
+	filter.SetThresholds(Profane, 2, 3, 4)
+	matches := filter.ScanMatches("darn it")
+	if len(matches) != 1 || matches[0].Type().Is(Profane) {
+		t.Errorf("expected one match below the threshold, got %+v", matches)
+	}
+
+	filter.SetThresholds(Profane, 1, 1, 1)
+	matches = filter.ScanMatches("darn it")
+	if len(matches) != 1 || !matches[0].Type().Is(Profane&Severe) {
+		t.Errorf("expected one severe match, got %+v", matches)
+	}
+
+	// As do the matches given to a strategy, including any overrides
+	var types []Type
+	strategy := func(match Match) string {
+		types = append(types, match.Type())
+		return "****"
+	}
+	filter.Censor("darn it", Profane, WithStrategy(strategy), WithThresholds(1, 2, 3))
+	if len(types) != 1 || !types[0].Is(Profane) || types[0].Is(Profane&Moderate) {
+		t.Errorf("expected one mild match, got %b", types)
+	}
+}
+
+func ExampleScanMatches() {
+	for _, match := range ScanMatches("what the sh1t") {
+		fmt.Println(match.Start, match.End, match.Text, match.Word, match.Type().Is(Profane))
+	}
+	// Output: 9 13 sh1t shit true
+}
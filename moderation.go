@@ -3,10 +3,7 @@ package moderation
 
 import (
 	"github.com/finnbear/moderation/internal/radix"
-	"golang.org/x/text/runes"
-	"golang.org/x/text/transform"
-	"golang.org/x/text/unicode/norm"
-	"unicode"
+	"sync"
 )
 
 // Types and severities of inappropriateness
@@ -16,45 +13,82 @@ import (
 //
 // Use a bitwise OR of multiple profanity classifications, and a bitwise AND to
 // specify a severity level (default Mild). The definition of Inappropriate
-// (mildly profane, mildly offensive, mildly sexual, or severely mean) serves
-// as a good example.
+// (mildly profane, mildly offensive, mildly sexual, severely mean, or severely
+// violent) serves as a good example.
 //
 // Other operations on Type's are NOT supported.
 //
 // Severities sould be interpreteted on an "at least" basis, e.g. Mild means
 // Mild, Moderate, OR Severe.
-type Type uint32
-
+//
+// SelfHarm is encouragement of self-harm aimed at others (e.g. "kys") and
+// mentions of it (e.g. "suicide"), whereas Distress is first-person disclosure
+// of self-harm or suicidal thoughts (e.g. "I want to die"), so that the latter
+// may be met with support rather than punishment. Which of the two a word is
+// depends only on the dictionary, not on what else the text contains.
+//
+// More countable types (e.g. "gambling") may be added with RegisterCategory.
+//
+// Type is a uint64, rather than the uint32 it used to be, because the 3 bits
+// of each built-in type, and of up to 9 registered categories, don't fit in
+// 32 bits. Code that stores a Type in a uint32 must be changed to use Type,
+// and a Type stored as a number must not be reused, as Spam moved from bits
+// 12-14 to bits 48-50.
+type Type uint64
+
+// Built-in countable types, which are counted from the levels of each word
 const (
 	Profane Type = 0b111 << (iota * 3)
 	Offensive
 	Sexual
 	Mean
-	Spam
-	Inappropriate = Profane | Offensive | Sexual | (Mean & Severe)
-	Any           = Profane | Offensive | Sexual | Spam | Mean
+	Violent
+	SelfHarm
+	Distress
+
+	builtinCategories = iota
+)
+
+// Types that are detected by analyzing the text as a whole
+const (
+	Spam Type = 0b111 << ((countableTypes + iota) * 3)
+	ContactInfo
+
+	typeGroups = countableTypes + iota
+)
+
+const (
+	Inappropriate = Profane | Offensive | Sexual | (Mean & Severe) | (Violent & Severe)
+	// Any leaves out ContactInfo, which is not inappropriate in itself, so
+	// that checks for Any aren't affected by it
+	Any = Mild &^ ContactInfo // including registered categories
 
-	Mild     Type = 0b111_111_111_111_111
-	Moderate      = 0b110_110_110_110_110
-	Severe        = 0b100_100_100_100_100
+	Mild     = severityBits * 0b111
+	Moderate = severityBits * 0b110
+	Severe   = severityBits * 0b100
 
-	countableTypes = 4
+	// Has 0b001 in each group of 3 bits
+	severityBits Type = (1<<(typeGroups*3) - 1) / 0b111
+
+	// Built-in and registered countable types
+	countableTypes = radix.MaxLevels
 
 	// A subset of the ASCII range that requires no sanitization
 	minNormal rune = 0x0020
 	maxNormal rune = 0x007E
 )
 
+// Used by the package level functions, and only loaded once one is called
 var (
-	tree radix.Tree = radix.New()
-
-	removeAccentsTransform = transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn)), norm.NFC)
+	defaultFilterOnce  sync.Once
+	defaultFilterValue *Filter
 )
 
-func init() {
-	for _, wv := range wordValues {
-		tree.Add(wv.word, wv.value)
-	}
+func defaultFilter() *Filter {
+	defaultFilterOnce.Do(func() {
+		defaultFilterValue = NewFilter()
+	})
+	return defaultFilterValue
 }
 
 // IsInappropriate returns whether a phrase contains enough inappropriate words
@@ -64,180 +98,23 @@ func init() {
 //  moderation.Scan(text).Is(moderation.Inappropriate)
 //
 func IsInappropriate(text string) bool {
-	return Scan(text).Is(Inappropriate)
+	return defaultFilter().IsInappropriate(text)
 }
 
 // Scan returns a bitmask of all types detected within given text, which can
 // be queried with the Is function
-func Scan(text string) (scanResult Type) {
-	// Figure out if sanitization is needed, and if so, do it
-	for _, textRune := range text {
-		if textRune < minNormal || maxNormal < textRune {
-			// Sanitize
-			buf := make([]byte, 0, len(text))
-			_, n, _ := transform.Append(removeAccentsTransform, buf, []byte(text))
-			text = string(buf[:n])
-
-			// Done sanitizing, stop scanning
-			break
-		}
-	}
-
-	// Scan status
-	var matches radix.Queue
-	var countableTypeLevels [countableTypes]int
-	separate := true // whether the previous character was a separator
-	var lastMatchable byte
-
-	// For spam detection purposes
-	var upperCount int
-	var repetitionCount int
-
-	for _, textRune := range text {
-		textByte := byte(textRune)
-		var textBytes string
-
-		matchable := false
-		replaced := false
-		skippable := false
-
-		var replacement string
-		if int(textByte) < len(replacements) {
-			replacement = replacements[textByte]
-		} else if textRune > maxNormal {
-			replacement = runeReplacements[textRune]
-			if replacement == "" {
-				lowerRune := unicode.ToLower(textRune)
-				replacement = runeReplacements[lowerRune]
-			}
-		}
-
-		switch {
-		case textByte >= 'a' && textByte <= 'z': // most likely case
-			matchable = true
-		case textByte >= 'A' && textByte <= 'Z':
-			upperCount++
-			textByte += 'a' - 'A'
-			matchable = true
-		case replacement != "": // if there is a valid set of replacements
-			textByte = replacement[0]
-			textBytes = replacement
-			matchable = true
-		default:
-			// matchable = false implied
-			switch textByte {
-			case '*': // these count as replacements
-				replaced = true
-				fallthrough
-			case ' ', '~', '-', '_', '.', ',', '\n', '\r', '\t': // false positives may contain these
-				skippable = true
-			}
-		}
-
-		if matchable {
-			if textByte == lastMatchable {
-				repetitionCount++
-			}
-
-			// Add a new blank match to assume the new byte(s)
-			//println(string([]byte{textByte}), "\t", separate)
-			matches.AppendUnique(radix.Match{Node: tree.Root(), Length: 0, Replaced: false, Separate: separate})
-			//println("+", "root", separate, replaced)
-			originalLength := matches.Len()
-			for m := 0; m < originalLength; m++ {
-				match := matches.Remove()
-
-				// Technically should compare to previous byte of given match,
-				// but this would be slower and give similar results for the
-				// given replacements
-				if (skippable || textByte == lastMatchable) && match.Length > 0 {
-					// Undo remove (and add one to length)
-					matches.AppendUnique(radix.Match{Node: match.Node, Length: match.Length + 1, Replaced: replaced || match.Replaced, Separate: match.Separate})
-					//println("=", match.Node.Depth(), match.Separate, match.Replaced)
-				} else {
-					//println("-", match.Node.Depth(), match.Separate, match.Replaced)
-				}
-
-				// Process textBytes as multiple textBytes or textByte
-				loops := 1
-				if len(textBytes) > 1 {
-					loops = len(textBytes)
-				}
-
-				for l := 0; l < loops; l++ {
-					loopTextByte := textByte
-					if len(textBytes) > 0 {
-						loopTextByte = textBytes[l]
-					}
-
-					next := match.Node.Next(loopTextByte)
-
-					if next == nil {
-						continue
-					}
-
-					if next.Word() {
-						if next.Depth() > 4 || (next.Depth() > 3 && next.Start() != 's') || match.Separate {
-							data := next.Data()
-							for i := 0; i < countableTypes; i++ {
-								level := int(int8(data >> (i * 8)))
-
-								// False positives that contain replacements are not matched
-								if level > 0 || !(match.Replaced || replaced) {
-									countableTypeLevels[i] += level
-								}
-							}
-						}
-					}
-
-					matches.Append(radix.Match{Node: next, Length: match.Length + 1, Replaced: replaced || match.Replaced, Separate: match.Separate})
-					//println("+", next.Depth(), match.Separate, match.Replaced)
-				}
-			}
-
-			lastMatchable = textByte
-		} else if skippable {
-			// Mark all matches as separate
-			for m := 0; m < matches.Len(); m++ {
-				match := matches.Remove()
-				match.Separate = false
-				matches.Append(match)
-			}
-		} else {
-			matches.Clear()
-		}
-
-		separate = skippable || !matchable
-	}
-
-	for i, level := range countableTypeLevels {
-		var severity Type
-
-		if level >= 3 {
-			severity = 0b100 // severe
-		} else if level == 2 {
-			severity = 0b010 // moderate
-		} else if level == 1 {
-			severity = 0b001 // mild
-		}
-
-		scanResult |= severity << (i * 3)
-	}
-
-	// Min length is arbitrary, but must be > 0 to avoid dividing by zero
-	if len(text) > 5 {
-		spamPercent := (100 / 2) * (upperCount + repetitionCount) / len(text)
-
-		// TODO: Define severe spam
-
-		if spamPercent > 50 {
-			scanResult |= 0b010 << (4 * 3) // moderate spam
-		} else if spamPercent > 30 {
-			scanResult |= 0b001 << (4 * 3) // mild spam
-		}
-	}
-
-	return
+//
+// Equivalent to calling Scan on a Filter returned by NewFilter
+func Scan(text string, opts ...Option) Type {
+	return defaultFilter().Scan(text, opts...)
+}
+
+// ScanMatches returns every dictionary word found in text, in the order they
+// end
+//
+// Equivalent to calling ScanMatches on a Filter returned by NewFilter
+func ScanMatches(text string) []Match {
+	return defaultFilter().ScanMatches(text)
 }
 
 // Is returns whether the scan result includes a given Type or set of Type's
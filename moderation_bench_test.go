@@ -1,7 +1,11 @@
 package moderation
 
 import (
+	"github.com/finnbear/moderation/internal/radix"
+	"runtime"
+	"strings"
 	"testing"
+	"time"
 )
 
 var IsProfane = IsInappropriate
@@ -90,3 +94,85 @@ func BenchmarkIsProfaneWhenLongStringHasProfanityAtTheEnd(b *testing.B) {
 	}
 	b.ReportAllocs()
 }
+
+func BenchmarkCensorLongString(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		Censor("Hello John Doe, I hope you're feeling well, as I come today bearing shitty news regarding your favorite chocolate chip cookie bitch", Inappropriate)
+	}
+	b.ReportAllocs()
+}
+
+// BenchmarkScanOverlappingWords scans text in which every other letter starts
+// several partial matches of dictionary words, which overlap each other
+func BenchmarkScanOverlappingWords(b *testing.B) {
+	filter := newFilter()
+	for i := 1; i <= 12; i++ {
+		filter.AddWord(strings.Repeat("ab", i)+"c", 0, 0, 0, 1)
+	}
+	text := strings.Repeat("ab", 500)
+	filter.Scan(text)
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		filter.Scan(text)
+	}
+	b.ReportAllocs()
+}
+
+func BenchmarkNewFilter(b *testing.B) {
+	for n := 0; n < b.N; n++ {
+		NewFilter()
+	}
+	b.ReportAllocs()
+}
+
+// BenchmarkDictionaryMemory reports the memory that the default dictionary
+// retains once loaded
+func BenchmarkDictionaryMemory(b *testing.B) {
+	var filter *Filter
+	var before, after runtime.MemStats
+	for n := 0; n < b.N; n++ {
+		runtime.GC()
+		runtime.ReadMemStats(&before)
+		filter = NewFilter()
+		runtime.GC()
+		runtime.ReadMemStats(&after)
+	}
+	b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc), "B/dictionary")
+	b.ReportMetric(float64(after.HeapAlloc-before.HeapAlloc)/float64(filter.tree.Len()), "B/word")
+	runtime.KeepAlive(filter)
+}
+
+// BenchmarkDictionaryLookup looks up every word in the default dictionary one
+// letter at a time, the same way as scanning
+func BenchmarkDictionaryLookup(b *testing.B) {
+	tree := &defaultFilter().tree
+	var words []string
+	tree.Traverse(func(word string, _ radix.Levels) {
+		if strings.IndexByte(word, ' ') != -1 {
+			// Phrases end with a word boundary
+			word += " "
+		}
+		words = append(words, word)
+	})
+	b.ResetTimer()
+	start := time.Now()
+
+	letters := 0
+	for n := 0; n < b.N; n++ {
+		for _, word := range words {
+			node := tree.Root()
+			for i := 0; i < len(word) && node != nil; i++ {
+				if word[i] == ' ' {
+					node = node.Boundary()
+				} else {
+					node = node.Next(word[i])
+				}
+			}
+			if node == nil || !node.Word() {
+				b.Fatalf("word \"%s\" not found", word)
+			}
+			letters += len(word)
+		}
+	}
+	b.ReportMetric(float64(time.Since(start).Nanoseconds())/float64(letters), "ns/letter")
+}
@@ -3,6 +3,7 @@ package moderation
 import (
 	"encoding/csv"
 	"fmt"
+	"github.com/finnbear/moderation/internal/radix"
 	"io"
 	"os"
 	"strings"
@@ -37,6 +38,8 @@ func TestIsInappropriate(t *testing.T) {
 		{"what a bunch of bullsh1t", true},
 		{"bitčh", true},
 		{"assassin", false},
+		{"a55a55in", false}, // replacements don't stop false positives from cancelling out
+		{"assa*ssin", true}, // but masking characters do, as they may stand for any letter
 		{"push it", false},
 		{"carcass", false},
 		{"retarded", true},
@@ -114,6 +117,254 @@ func ExampleScan() {
 	// Output: true false false true false
 }
 
+func TestViolent(t *testing.T) {
+	// severity is 0 for none, or 1, 2, or 3 for Mild, Moderate, or Severe
+	type TestCase struct {
+		phrase   string
+		severity int
+	}
+	testCases := []TestCase{
+		{"I will kill you", 3},
+		{"im gonna k1ll y0u", 3},
+		{"going to shoot up the school tomorrow", 3},
+		{"I know where you live", 3},
+		{"I will kill your family", 3},
+		{"I will kill your dog", 3},
+		{"I'm going to kill your kids", 3},
+		{"I'll beat you up", 2},
+		{"they reported on the school shooting", 1},
+		{"kill the boss to win", 0},
+		{"kill your enemies first", 0},
+		{"kill your darlings", 0},
+		{"kill your engine", 0},
+		{"kill your speed before the turn", 0},
+		{"prices shoot up", 0},
+		{"you have skill, you know", 0},
+		{"skillyou", 0},
+		{"skill your family", 0},
+		{"knowwhereyoulive", 0},
+		{"shoot your shot", 0},
+		{"I don't want to hurt your feelings", 0},
+		{"kill yourself", 0},
+	}
+
+	for _, testCase := range testCases {
+		result := Scan(testCase.phrase)
+
+		severity := 0
+		if result.Is(Violent & Severe) {
+			severity = 3
+		} else if result.Is(Violent & Moderate) {
+			severity = 2
+		} else if result.Is(Violent) {
+			severity = 1
+		}
+
+		if severity != testCase.severity {
+			t.Errorf("phrase=\"%s\" severity=%d expected=%d", testCase.phrase, severity, testCase.severity)
+		}
+	}
+
+	if !IsInappropriate("I will kill you") {
+		t.Error("expected threat to be inappropriate")
+	}
+}
+
+func ExampleScan_violent() {
+	fmt.Println(Scan("I will kill you").Is(Violent&Severe), Scan("kill the boss").Is(Violent))
+	// Output: true false
+}
+
+func TestSelfHarm(t *testing.T) {
+	type TestCase struct {
+		phrase string
+		// Whether encouragement of self-harm aimed at others (SelfHarm), or a
+		// first-person disclosure (Distress)
+		encouragement bool
+		disclosure    bool
+	}
+	testCases := []TestCase{
+		{"kys", true, false},
+		{"just kill yourself", true, false},
+		{"go hang yourself", true, false},
+		{"go die", true, false},
+		{"I want to die", false, true},
+		{"i just want to kill myself", false, true},
+		{"I'm going to end my life", false, true},
+		{"I have been feeling suicidal", false, true},
+		{"this game is stupid, I want to die", false, true},
+		{"I wish I was dead", false, true},
+		{"I'd be better off dead", false, true},
+		{"just drink bleach", true, false},
+		{"slit your wrists", true, false},
+		{"nobody would miss you", true, false},
+		{"I want to diet", false, false},
+		{"you should change yourself", false, false},
+		{"I want to spend my life with you", false, false},
+		{"I want to skill myself up", false, false},
+		{"the amendmylife app", false, false},
+		{"have you seen suicide squad", false, false},
+		{"skys are blue", false, false},
+	}
+
+	for _, testCase := range testCases {
+		result := Scan(testCase.phrase)
+		encouragement := result.Is(SelfHarm & Moderate)
+		disclosure := result.Is(Distress & Moderate)
+		if encouragement != testCase.encouragement || disclosure != testCase.disclosure {
+			t.Errorf("phrase=\"%s\" encouragement=%v disclosure=%v expected %v %v", testCase.phrase, encouragement, disclosure, testCase.encouragement, testCase.disclosure)
+		}
+	}
+
+	// Mild disclosures, which some everyday phrases cancel out
+	mildCases := []TestCase{
+		{"I cut myself again", false, true},
+		{"I hurt myself on purpose", false, true},
+		{"I'll cut myself a slice of cake", false, false},
+		{"I hurt myself playing football", false, false},
+	}
+	for _, testCase := range mildCases {
+		if disclosure := Scan(testCase.phrase).Is(Distress); disclosure != testCase.disclosure {
+			t.Errorf("phrase=\"%s\" disclosure=%v expected %v", testCase.phrase, disclosure, testCase.disclosure)
+		}
+	}
+
+	if IsInappropriate("I want to die") {
+		t.Error("expected disclosure to not be inappropriate")
+	}
+	if !IsInappropriate("kys") {
+		t.Error("expected encouragement to be inappropriate")
+	}
+}
+
+func ExampleType_Is_selfHarm() {
+	for _, phrase := range []string{"kys", "I want to die"} {
+		result := Scan(phrase)
+		if result.Is(Distress) {
+			fmt.Println("disclosure")
+		} else if result.Is(SelfHarm) {
+			fmt.Println("encouragement")
+		}
+	}
+	// Output:
+	// encouragement
+	// disclosure
+}
+
+func TestPhrases(t *testing.T) {
+	type TestCase struct {
+		phrase string
+		mean   bool
+	}
+	testCases := []TestCase{
+		{"go die", true},
+		{"just go die already", true},
+		{"GO DIE", true},
+		{"go  die", true},
+		{"go-die", true},
+		{"go die!", true},
+		{"g0 d1e", true},
+		{"drop dead", true},
+		{"nobody likes you", true},
+		{"godie", false},
+		{"ego dies", false},
+		{"go diet", false},
+		{"go, die", true},
+		{"let's go dig", false},
+	}
+
+	for _, testCase := range testCases {
+		if mean := Scan(testCase.phrase).Is(Mean); mean != testCase.mean {
+			t.Errorf("phrase=\"%s\" mean=%v expected=%v", testCase.phrase, mean, testCase.mean)
+		}
+	}
+}
+
+func TestOverlappingWords(t *testing.T) {
+	filter := newFilter()
+	for _, word := range []string{"abcde", "cdefg", "bcdefgh", "defx"} {
+		filter.AddWord(word, 0, 0, 0, 1)
+	}
+
+	// Every word is found, even if it starts inside a longer partial match
+	var found []string
+	for _, match := range filter.ScanMatches("abcdefgh abcdefx") {
+		found = append(found, fmt.Sprintf("%s@%d", match.Word, match.Start))
+	}
+	expected := "abcde@0 cdefg@2 bcdefgh@1 abcde@9 defx@12"
+	if strings.Join(found, " ") != expected {
+		t.Errorf("found=%v expected=%s", found, expected)
+	}
+
+	// Words added after scanning are found by later scans
+	filter.AddWord("ghab", 0, 0, 0, 1)
+	if matches := filter.ScanMatches("fghab"); len(matches) != 1 || matches[0].Start != 1 {
+		t.Errorf("added word not found, matches=%+v", matches)
+	}
+
+	// Repeated letters don't count a word more than once
+	if fuck, repeated := ScanDetailed("fuck").Levels, ScanDetailed("ffffuuuck").Levels; fuck != repeated {
+		t.Errorf("levels=%v expected=%v", repeated, fuck)
+	}
+}
+
+func TestPathologicalReplacements(t *testing.T) {
+	// Every combination of "l" and "i" (which "1" and "!" both stand for)
+	// starts a word, so each character doubles the number of matches
+	filter := newFilter()
+	var add func(prefix string)
+	add = func(prefix string) {
+		for _, letter := range []string{"l", "i"} {
+			filter.AddWord(prefix+letter+"x", 0, 0, 0, 1)
+			if len(prefix) < 8 {
+				add(prefix + letter)
+			}
+		}
+	}
+	add("")
+	filter.AddWord("fuck", 2)
+	filter.AllowWord("liliili")
+
+	for _, run := range []string{"1!3", "!1", "1!*", "1!1 "} {
+		text := strings.Repeat(run, 1000)
+		var state scanState
+		state.init(filter, newOptions(nil))
+		state.scanString(text, 0)
+		if state.matches.Len() > radix.QueueSize {
+			t.Fatalf("run=\"%s\" %d matches queued", run, state.matches.Len())
+		}
+		if run == "!1" && state.matches.Discarded() == 0 {
+			t.Errorf("run=\"%s\" expected matches to be discarded", run)
+		}
+
+		// Words are still matched after the queue overflows
+		state.scanString("fuck", len(text))
+		if !state.finish().Is(Profane) {
+			t.Errorf("run=\"%s\" word after run not matched", run)
+		}
+		if !filter.Scan(text + " 1!!x").Is(Mean) {
+			t.Errorf("run=\"%s\" separate word after run not matched", run)
+		}
+
+		// The same holds for the default dictionary
+		if !Scan(text + "fuck").Is(Profane) {
+			t.Errorf("run=\"%s\" default dictionary word after run not matched", run)
+		}
+		var buf strings.Builder
+		writer := NewCensorWriter(&buf, Profane)
+		writer.Write([]byte(text + "fuck"))
+		writer.Close()
+		if !strings.HasSuffix(buf.String(), "f***") {
+			t.Errorf("run=\"%s\" not censored", run)
+		}
+	}
+}
+
+func ExampleScan_phrase() {
+	fmt.Println(Scan("go die").Is(Mean), Scan("go diet").Is(Mean))
+	// Output: true false
+}
+
 func TestIsInappropriateWikipedia(t *testing.T) {
 	wikiModerationData, err := os.Open("wikipedia-test.csv")
 	if err != nil {
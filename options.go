@@ -0,0 +1,72 @@
+package moderation
+
+import "fmt"
+
+// Option configures a single call to Scan or Censor, without affecting any
+// other calls, so different options may be used concurrently
+type Option func(*options)
+
+type options struct {
+	strategy    CensorStrategy
+	replacement rune
+	thresholds  thresholds
+	overridden  bool // whether thresholds override those of the filter
+}
+
+// thresholds are the minimum levels for Mild, Moderate, and Severe
+type thresholds [3]int
+
+// newThresholds returns the given thresholds, or panics unless they satisfy
+// 0 < mild <= moderate <= severe
+func newThresholds(mild, moderate, severe int) thresholds {
+	if !(0 < mild && mild <= moderate && moderate <= severe) {
+		panic(fmt.Sprintf("moderation: invalid thresholds %d, %d, %d", mild, moderate, severe))
+	}
+	return thresholds{mild, moderate, severe}
+}
+
+var defaultThresholds = thresholds{1, 2, 3}
+
+// WithStrategy sets how Censor replaces inappropriate words, by default
+// MaskKeepFirst with the replacement set by WithReplacement
+func WithStrategy(strategy CensorStrategy) Option {
+	return func(options *options) {
+		options.strategy = strategy
+	}
+}
+
+// WithReplacement sets the rune that the default strategy of Censor replaces
+// characters with, by default '*'
+func WithReplacement(replacement rune) Option {
+	return func(options *options) {
+		options.replacement = replacement
+	}
+}
+
+// WithThresholds sets the minimum level (sum of the levels of all words) of
+// every type that is considered Mild, Moderate, and Severe, overriding those
+// of the filter, which are by default 1, 2, and 3
+//
+// WithThresholds panics unless 0 < mild <= moderate <= severe, as a threshold
+// of zero or less would be met by every text, and a text that is Severe must
+// also be Moderate and Mild.
+func WithThresholds(mild, moderate, severe int) Option {
+	t := newThresholds(mild, moderate, severe)
+	return func(options *options) {
+		options.thresholds = t
+		options.overridden = true
+	}
+}
+
+func newOptions(opts []Option) options {
+	if len(opts) == 0 {
+		// Options that are passed to an Option escape to the heap, so only
+		// allocate them if there are any
+		return options{replacement: CensorReplacment}
+	}
+	options := &options{replacement: CensorReplacment}
+	for _, opt := range opts {
+		opt(options)
+	}
+	return *options
+}
@@ -0,0 +1,104 @@
+package moderation
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestWithReplacement(t *testing.T) {
+	var wait sync.WaitGroup
+	for _, replacement := range []rune{'#', '-', '•', '*'} {
+		wait.Add(1)
+		go func(replacement rune) {
+			defer wait.Done()
+			expected := "what the s" + string([]rune{replacement, replacement, replacement})
+			for i := 0; i < 100; i++ {
+				if censored, _ := Censor("what the sh1t", Inappropriate, WithReplacement(replacement)); censored != expected {
+					t.Errorf("censored=\"%s\" expected=\"%s\"", censored, expected)
+					return
+				}
+			}
+		}(replacement)
+	}
+	wait.Wait()
+}
+
+func TestWithThresholds(t *testing.T) {
+	// "fuck" is profane level 2, and "ass" level 2
+	type TestCase struct {
+		phrase     string
+		thresholds [3]int
+		expected   Type
+	}
+	testCases := []TestCase{
+		{"fuck", [3]int{1, 2, 3}, Profane & Moderate},
+		{"fuck", [3]int{1, 3, 5}, Profane & Mild},
+		{"fuck", [3]int{3, 4, 5}, 0},
+		{"fuck ass", [3]int{1, 3, 5}, Profane & Moderate},
+		{"fuck ass", [3]int{1, 2, 4}, Profane & Severe},
+		{"fuck ass", [3]int{5, 6, 7}, 0},
+	}
+	for _, testCase := range testCases {
+		thresholds := testCase.thresholds
+		result := Scan(testCase.phrase, WithThresholds(thresholds[0], thresholds[1], thresholds[2]))
+
+		// Severities are on an "at least" basis, so check the exact severity
+		// by making sure the next one up is not met
+		var exact bool
+		switch testCase.expected {
+		case Profane & Severe:
+			exact = result.Is(Profane & Severe)
+		case Profane & Moderate:
+			exact = result.Is(Profane&Moderate) && !result.Is(Profane&Severe)
+		case Profane & Mild:
+			exact = result.Is(Profane&Mild) && !result.Is(Profane&Moderate)
+		default:
+			exact = !result.Is(Profane)
+		}
+		if !exact {
+			t.Errorf("phrase=\"%s\" thresholds=%v result=%b expected=%b", testCase.phrase, thresholds, result, testCase.expected)
+		}
+	}
+
+	if censored, _ := Censor("fuck", Profane, WithThresholds(3, 4, 5)); censored != "fuck" {
+		t.Errorf("censored=\"%s\" below threshold", censored)
+	}
+}
+
+func TestThresholdsInvalid(t *testing.T) {
+	// Invalid thresholds are rejected the same way by both WithThresholds
+	// and SetThresholds
+	filter := NewFilter()
+	setters := map[string]func(mild, moderate, severe int){
+		"WithThresholds": func(mild, moderate, severe int) {
+			WithThresholds(mild, moderate, severe)
+		},
+		"SetThresholds": func(mild, moderate, severe int) {
+			filter.SetThresholds(Profane, mild, moderate, severe)
+		},
+	}
+	for name, set := range setters {
+		for _, thresholds := range [][3]int{{0, 0, 0}, {-1, 2, 3}, {0, 2, 3}, {1, 3, 2}, {2, 1, 3}} {
+			func() {
+				defer func() {
+					if recover() == nil {
+						t.Errorf("%s thresholds=%v expected panic", name, thresholds)
+					}
+				}()
+				set(thresholds[0], thresholds[1], thresholds[2])
+			}()
+		}
+	}
+
+	// The filter is unchanged
+	if result := filter.Scan("ass"); !result.Is(Profane) {
+		t.Errorf("result=%b expected default thresholds", result)
+	}
+}
+
+func ExampleWithReplacement() {
+	censored, _ := Censor("what the sh1t", Inappropriate, WithReplacement('#'))
+	fmt.Println(censored)
+	// Output: what the s###
+}
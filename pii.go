@@ -0,0 +1,559 @@
+package moderation
+
+import (
+	"bytes"
+	"golang.org/x/text/unicode/norm"
+	"sort"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// PIIKind is a kind of personally identifiable information
+type PIIKind int
+
+const (
+	PIIEmail      PIIKind = iota
+	PIIPhone              // phone numbers
+	PIICard               // Luhn-valid credit card numbers
+	PIIIBAN               // international bank account numbers
+	PIINationalID         // US SSN, Brazilian CPF, and UK NINO
+)
+
+// String returns the name of the kind, which is also used in placeholders
+// (e.g. "email" for "[email]")
+func (kind PIIKind) String() string {
+	switch kind {
+	case PIIEmail:
+		return "email"
+	case PIIPhone:
+		return "phone"
+	case PIICard:
+		return "card"
+	case PIIIBAN:
+		return "iban"
+	case PIINationalID:
+		return "id"
+	}
+	return "pii"
+}
+
+// PIIMatch is personally identifiable information found in a text
+type PIIMatch struct {
+	// Byte offsets into the text, [Start, End)
+	Start, End int
+
+	// The original text, text[Start:End]
+	Text string
+
+	Kind PIIKind
+}
+
+// RedactPII replaces email addresses, phone numbers, credit card numbers,
+// IBANs, and national IDs with placeholders of their kind (e.g. "[email]"),
+// and returns what was replaced in the order it appears
+//
+// Like Scan, accents are removed and confusable characters (e.g. Cyrillic
+// 'о') are replaced before matching, so they can't be used to evade it.
+func RedactPII(text string) (string, []PIIMatch) {
+	chars := normalizePII(text)
+
+	var found []piiSpan
+	found = findEmails(chars, found)
+	found = findAccounts(chars, found)
+	found = findNumbers(chars, found)
+	if len(found) == 0 {
+		return text, nil
+	}
+
+	sort.Slice(found, func(i, j int) bool {
+		return found[i].start < found[j].start
+	})
+
+	matches := make([]PIIMatch, len(found))
+	var builder strings.Builder
+	builder.Grow(len(text))
+	last := 0
+	for i, span := range found {
+		start, end := chars[span.start].start, chars[span.end-1].end
+		matches[i] = PIIMatch{Start: start, End: end, Text: text[start:end], Kind: span.kind}
+
+		builder.WriteString(text[last:start])
+		builder.WriteByte('[')
+		builder.WriteString(span.kind.String())
+		builder.WriteByte(']')
+		last = end
+	}
+	builder.WriteString(text[last:])
+
+	return builder.String(), matches
+}
+
+// piiChar is a normalized character that came from [start, end) of the
+// original text
+type piiChar struct {
+	span
+	char byte
+}
+
+// piiLetter is a normalized character that stands for any letter that has
+// no ASCII equivalent
+const piiLetter byte = 0xFF
+
+// piiSpan is PII found in [start, end) of the normalized characters
+type piiSpan struct {
+	start, end int
+	kind       PIIKind
+}
+
+// normalizePII normalizes text to lowercase ASCII, removing accents and
+// replacing confusable characters the same way as Scan, but keeping digits
+// as digits instead of treating them as replacements
+func normalizePII(text string) []piiChar {
+	chars := make([]piiChar, 0, len(text))
+	for i := 0; i < len(text); {
+		textRune, size := rune(text[i]), 1
+		if textRune >= utf8.RuneSelf {
+			textRune, size = utf8.DecodeRuneInString(text[i:])
+		}
+		s := span{start: i, end: i + size}
+
+		decomposition := norm.NFD.PropertiesString(text[i : i+size]).Decomposition()
+		runes, count := unaccented(textRune, decomposition)
+		for _, unaccentedRune := range runes[:count] {
+			chars = append(chars, piiChar{span: s, char: normalizePIIRune(unaccentedRune)})
+		}
+		i += size
+	}
+	return chars
+}
+
+// normalizePIIRune returns the lowercase ASCII equivalent of a rune, or zero
+// if there is none
+func normalizePIIRune(textRune rune) byte {
+	if textRune < utf8.RuneSelf {
+		if textRune >= 'A' && textRune <= 'Z' {
+			textRune += 'a' - 'A'
+		}
+		return byte(textRune)
+	}
+	if textRune >= '！' && textRune <= '～' {
+		// Fullwidth forms of ASCII, like '＠'
+		return normalizePIIRune(textRune - '！' + '!')
+	}
+	if digit := digitValue(textRune); digit >= 0 {
+		return '0' + byte(digit)
+	}
+	if replacement := runeReplacement(textRune); replacement != "" {
+		return replacement[0]
+	}
+	if unicode.IsLetter(textRune) {
+		return piiLetter
+	}
+	return 0
+}
+
+// digitValue returns the value of a decimal digit in any script, or -1 if
+// the rune is not one
+func digitValue(textRune rune) int {
+	if !unicode.IsDigit(textRune) {
+		return -1
+	}
+	// Decimal digits come in runs of ten, starting with zero
+	for _, r := range unicode.Nd.R16 {
+		if rune(r.Lo) <= textRune && textRune <= rune(r.Hi) {
+			return int(textRune-rune(r.Lo)) % 10
+		}
+	}
+	for _, r := range unicode.Nd.R32 {
+		if rune(r.Lo) <= textRune && textRune <= rune(r.Hi) {
+			return int(textRune-rune(r.Lo)) % 10
+		}
+	}
+	return -1
+}
+
+func isPIIDigit(char byte) bool {
+	return char >= '0' && char <= '9'
+}
+
+func isPIILetter(char byte) bool {
+	return (char >= 'a' && char <= 'z') || char == piiLetter
+}
+
+func isPIIAlphanumeric(char byte) bool {
+	return isPIIDigit(char) || isPIILetter(char)
+}
+
+// overlaps returns whether [start, end) overlaps any of the found spans
+func overlaps(found []piiSpan, start, end int) bool {
+	for _, other := range found {
+		if start < other.end && other.start < end {
+			return true
+		}
+	}
+	return false
+}
+
+// findEmails appends every email address to found
+func findEmails(chars []piiChar, found []piiSpan) []piiSpan {
+	isLocal := func(char byte) bool {
+		return isPIIAlphanumeric(char) || strings.IndexByte("._%+-", char) != -1
+	}
+	isDomain := func(char byte) bool {
+		return isPIIAlphanumeric(char) || char == '.' || char == '-'
+	}
+
+	for i, c := range chars {
+		if c.char != '@' {
+			continue
+		}
+
+		start := i
+		for start > 0 && isLocal(chars[start-1].char) {
+			start--
+		}
+		for start < i && chars[start].char == '.' {
+			start++
+		}
+
+		end := i + 1
+		for end < len(chars) && isDomain(chars[end].char) {
+			end++
+		}
+		for end > i+1 && (chars[end-1].char == '.' || chars[end-1].char == '-') {
+			end--
+		}
+
+		// The top level domain must have at least two letters
+		tld := end
+		for tld > i+1 && isPIILetter(chars[tld-1].char) {
+			tld--
+		}
+		if start == i || end-tld < 2 || tld <= i+2 || chars[tld-1].char != '.' || chars[i+1].char == '.' {
+			continue
+		}
+		if !overlaps(found, start, end) {
+			found = append(found, piiSpan{start: start, end: end, kind: PIIEmail})
+		}
+	}
+	return found
+}
+
+// findAccounts appends every IBAN and UK NINO to found
+func findAccounts(chars []piiChar, found []piiSpan) []piiSpan {
+	var buf []byte
+	for i := range chars {
+		if !isPIILetter(chars[i].char) || (i > 0 && isPIIAlphanumeric(chars[i-1].char)) {
+			continue
+		}
+
+		// IBANs may be written in groups of four separated by spaces
+		buf = buf[:0]
+		end, group := i, 0
+		for end < len(chars) && len(buf) < 34 {
+			char := chars[end].char
+			if isPIIAlphanumeric(char) {
+				buf = append(buf, char)
+				group++
+			} else if char != ' ' || group != 4 || end+1 == len(chars) || !isPIIAlphanumeric(chars[end+1].char) {
+				break
+			} else {
+				group = 0
+			}
+			end++
+		}
+		if (end == len(chars) || !isPIIAlphanumeric(chars[end].char)) && isIBAN(buf) && !overlaps(found, i, end) {
+			found = append(found, piiSpan{start: i, end: end, kind: PIIIBAN})
+			continue
+		}
+
+		// NINOs may be written with spaces anywhere
+		buf = buf[:0]
+		end = i
+		for end < len(chars) && len(buf) < 9 {
+			char := chars[end].char
+			if isPIIAlphanumeric(char) {
+				buf = append(buf, char)
+			} else if char != ' ' || len(buf) == 0 || end+1 == len(chars) || !isPIIAlphanumeric(chars[end+1].char) {
+				break
+			}
+			end++
+		}
+		if (end == len(chars) || !isPIIAlphanumeric(chars[end].char)) && isNINO(buf) && !overlaps(found, i, end) {
+			found = append(found, piiSpan{start: i, end: end, kind: PIINationalID})
+		}
+	}
+	return found
+}
+
+// isIBAN returns whether an IBAN has a valid structure and checksum
+func isIBAN(iban []byte) bool {
+	if len(iban) < 15 || len(iban) > 34 {
+		return false
+	}
+	for i, char := range iban {
+		if char == piiLetter || (i < 2 && !isPIILetter(char)) || (i >= 2 && i < 4 && !isPIIDigit(char)) {
+			return false
+		}
+	}
+
+	// The country code and check digits are moved to the end, and letters
+	// are replaced with 10-35
+	remainder := 0
+	for i := range iban {
+		char := iban[(i+4)%len(iban)]
+		if isPIIDigit(char) {
+			remainder = (remainder*10 + int(char-'0')) % 97
+		} else {
+			remainder = (remainder*100 + int(char-'a') + 10) % 97
+		}
+	}
+	return remainder == 1
+}
+
+// isNINO returns whether a UK national insurance number has a valid
+// structure
+func isNINO(nino []byte) bool {
+	if len(nino) != 9 {
+		return false
+	}
+	if strings.IndexByte("dfiquv", nino[0]) != -1 || strings.IndexByte("dfioquv", nino[1]) != -1 {
+		return false
+	}
+	switch string(nino[:2]) {
+	case "bg", "gb", "nk", "kn", "tn", "nt", "zz":
+		return false
+	}
+	for _, char := range nino[2:8] {
+		if !isPIIDigit(char) {
+			return false
+		}
+	}
+	return nino[8] >= 'a' && nino[8] <= 'd'
+}
+
+// findNumbers appends every card number, US SSN, Brazilian CPF, and phone
+// number to found
+func findNumbers(chars []piiChar, found []piiSpan) []piiSpan {
+	var digits, format []byte
+	for i := 0; i < len(chars); i++ {
+		if !isPIIDigit(chars[i].char) || (i > 0 && isPIIAlphanumeric(chars[i-1].char)) {
+			continue
+		}
+
+		// A run of digits with at most two separators between them (e.g.
+		// "(555) 123-4567"), which are recorded in its format
+		digits, format = digits[:0], format[:0]
+		start, end := i, i
+		if start > 0 && (chars[start-1].char == '(' || chars[start-1].char == '+') {
+			start--
+		}
+		separators := 0
+		for end < len(chars) {
+			char := chars[end].char
+			if isPIIDigit(char) {
+				digits = append(digits, char)
+				format = append(format, 'd')
+				separators = 0
+			} else if strings.IndexByte(" -.()/", char) != -1 && separators < 2 && end+1 < len(chars) && (isPIIDigit(chars[end+1].char) || strings.IndexByte(" -.()/", chars[end+1].char) != -1) {
+				format = append(format, char)
+				separators++
+			} else {
+				break
+			}
+			end++
+		}
+		// Trailing separators are not part of the number
+		for format[len(format)-1] != 'd' {
+			format = format[:len(format)-1]
+			end--
+		}
+		first := i
+		i = end
+
+		if end < len(chars) && isPIIAlphanumeric(chars[end].char) {
+			continue
+		}
+
+		if kind, ok := numberKind(digits, string(format)); ok {
+			if chars[start].char == '(' {
+				start++
+			}
+			if !overlaps(found, start, end) {
+				found = append(found, piiSpan{start: start, end: end, kind: kind})
+			}
+			continue
+		}
+		found = findPhones(chars, start, first, format, found)
+	}
+	return found
+}
+
+// numberKind returns the kind of PII other than a phone number that a run of
+// digits with the given format (e.g. "ddd-dd-dddd") is, if any
+func numberKind(digits []byte, format string) (PIIKind, bool) {
+	switch {
+	case format == "ddd.ddd.ddd-dd" && isCPF(digits):
+		return PIINationalID, true
+	case (format == "ddd-dd-dddd" || format == "ddd dd dddd") && isSSN(digits):
+		return PIINationalID, true
+	case len(digits) >= 13 && len(digits) <= 19 && strings.Trim(format, "d -") == "" && isLuhn(digits):
+		return PIICard, true
+	}
+	return 0, false
+}
+
+// findPhones appends every phone number in a run of digits with the given
+// format to found, where chars[first] is the first digit, and chars[start]
+// may be a '(' or '+' before it
+//
+// The run is split into numbers wherever a group of digits starts a new one
+// (see splitsNumber), such as between the years in "1999 2000".
+func findPhones(chars []piiChar, start, first int, format []byte, found []piiSpan) []piiSpan {
+	var groups []int
+	var previous digitGroup
+	numberStart, numberEnd := 0, 0 // in format
+	phone := func() {
+		from, to := first+numberStart, first+numberEnd
+		if numberStart == 0 {
+			from = start
+		}
+		if isPhoneNumber(groups, chars[from].char == '+') && bytes.IndexByte(format[numberStart:numberEnd], '/') == -1 && !overlaps(found, from, to) {
+			found = append(found, piiSpan{start: from, end: to, kind: PIIPhone})
+		}
+	}
+
+	var year [4]byte
+	for k := 0; k < len(format); k++ {
+		if format[k] != 'd' {
+			continue
+		}
+		groupEnd := k
+		for groupEnd < len(format) && format[groupEnd] == 'd' {
+			groupEnd++
+		}
+		group := digitGroup{digits: groupEnd - k}
+		if group.digits == len(year) {
+			for j := range year {
+				year[j] = chars[first+k+j].char
+			}
+			group.year = isYear(year[:])
+		}
+
+		if len(groups) > 0 && splitsNumber(previous, group) {
+			phone()
+			groups = groups[:0]
+			numberStart = k
+		}
+		groups = append(groups, group.digits)
+		previous = group
+		numberEnd = groupEnd
+		k = groupEnd - 1
+	}
+	phone()
+	return found
+}
+
+// digitGroup is a group of digits in a number, like "555" in "555-1234"
+type digitGroup struct {
+	digits int
+	year   bool // whether it looks like a year, like "2024"
+}
+
+// isYear returns whether a group of digits looks like a year
+func isYear(digits []byte) bool {
+	return len(digits) == 4 && (string(digits[:2]) == "19" || string(digits[:2]) == "20")
+}
+
+// splitsNumber returns whether a group of digits that follows another, with
+// only separators between them, starts a new number
+//
+// Phone numbers don't have consecutive groups of four digits (e.g. "1999
+// 2000 2001"), or years next to short groups like dates do (e.g.
+// "2024-10-18").
+func splitsNumber(previous, next digitGroup) bool {
+	return (previous.digits == 4 && next.digits == 4) ||
+		(next.year && previous.digits <= 2) ||
+		(previous.year && next.digits <= 2)
+}
+
+// isPhoneNumber returns whether groups of digits with only separators
+// between them (e.g. 3, 3, and 4 for "555-123-4567") may be a phone number,
+// which may have a leading '+'
+func isPhoneNumber(groups []int, plus bool) bool {
+	digits := 0
+	same := true
+	for _, group := range groups {
+		digits += group
+		if group != groups[0] {
+			same = false
+		}
+	}
+	if digits < 7 || digits > 15 {
+		return false
+	}
+
+	last := groups[len(groups)-1]
+	switch {
+	case last < 2:
+		// Unlike ISBNs (e.g. "978 3 16 148410 0"), phone numbers don't end
+		// in a single digit
+		return false
+	case same && len(groups) > 2 && last > 2 && !plus:
+		// Scores and amounts (e.g. "100 200 300 400")
+		return false
+	case digits >= 10 || plus:
+		return true
+	default:
+		// Shorter numbers must end in a group of at least four digits, like
+		// "555-1234", to avoid amounts like "1234567"
+		return len(groups) > 1 && last >= 4
+	}
+}
+
+// isLuhn returns whether a number has a valid Luhn check digit
+func isLuhn(digits []byte) bool {
+	sum := 0
+	for i := range digits {
+		digit := int(digits[len(digits)-1-i] - '0')
+		if i%2 == 1 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+// isSSN returns whether a US social security number is possible
+func isSSN(digits []byte) bool {
+	area, group, serial := string(digits[:3]), string(digits[3:5]), string(digits[5:])
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
+}
+
+// isCPF returns whether a Brazilian CPF has valid check digits
+func isCPF(digits []byte) bool {
+	same := true
+	for _, digit := range digits {
+		if digit != digits[0] {
+			same = false
+		}
+	}
+	if same {
+		return false
+	}
+
+	for check := 9; check <= 10; check++ {
+		sum := 0
+		for i := 0; i < check; i++ {
+			sum += int(digits[i]-'0') * (check + 1 - i)
+		}
+		if sum*10%11%10 != int(digits[check]-'0') {
+			return false
+		}
+	}
+	return true
+}
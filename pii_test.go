@@ -0,0 +1,102 @@
+package moderation
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestRedactPII(t *testing.T) {
+	type TestCase struct {
+		phrase   string
+		redacted string
+	}
+	testCases := []TestCase{
+		{"hello there", "hello there"},
+		{"see you at 5.30 on 2026-10-18", "see you at 5.30 on 2026-10-18"},
+		{"I have 1,000,000 gold and 1234567 xp", "I have 1,000,000 gold and 1234567 xp"},
+		{"the year 1999 2000 and 2001", "the year 1999 2000 and 2001"},
+		{"score 100 200 300 400", "score 100 200 300 400"},
+		{"12:30 2024 10 18 5", "12:30 2024 10 18 5"},
+		{"ISBN 978 3 16 148410 0", "ISBN 978 3 16 148410 0"},
+
+		// Emails
+		{"mail john.smith+spam@example.co.uk.", "mail [email]."},
+		{"(john@gmail.com)", "([email])"},
+		{"joão@exemplo.com.br", "[email]"},
+		{"jоhn@gmаil.com", "[email]"}, // Cyrillic о and а
+		{"john＠gmail．com", "[email]"}, // fullwidth
+		{"ｊｏｈｎ@ｇｍａｉｌ.ｃｏｍ", "[email]"},
+		{"john@localhost", "john@localhost"},
+		{"@john", "@john"},
+
+		// Phone numbers
+		{"call 555-123-4567 now", "call [phone] now"},
+		{"call (555) 123-4567", "call [phone]"},
+		{"+55 11 91234-5678", "[phone]"},
+		{"555-1234", "[phone]"},
+		{"５５５-１２３-４５６７", "[phone]"}, // fullwidth
+		{"01 23 45 67 89", "[phone]"},
+		{"on 2024-10-18 call 555-1234", "on 2024-10-18 call [phone]"},
+
+		// Cards
+		{"card 4111 1111 1111 1111 exp 12/30", "card [card] exp 12/30"},
+		{"4111-1111-1111-1111", "[card]"},
+		{"4111111111111111", "[card]"},
+		{"4111 1111 1111 1112", "4111 1111 1111 1112"},
+
+		// IBANs
+		{"GB82 WEST 1234 5698 7654 32", "[iban]"},
+		{"pay DE89370400440532013000 today", "pay [iban] today"},
+		{"DE89 3704 0044 0532 0130 00 and", "[iban] and"},
+		{"GB83WEST12345698765432", "GB83WEST12345698765432"},
+
+		// National IDs
+		{"ssn 123-45-6789", "ssn [id]"},
+		{"cpf 111.444.777-35", "cpf [id]"},
+		{"nino AB 12 34 56 C", "nino [id]"},
+		{"nino AB123456C", "nino [id]"},
+
+		{"john@example.com or 555-123-4567", "[email] or [phone]"},
+	}
+
+	for _, testCase := range testCases {
+		redacted, matches := RedactPII(testCase.phrase)
+		if redacted != testCase.redacted {
+			t.Errorf("phrase=\"%s\" redacted=\"%s\" expected=\"%s\"", testCase.phrase, redacted, testCase.redacted)
+		}
+		for _, match := range matches {
+			if testCase.phrase[match.Start:match.End] != match.Text {
+				t.Errorf("phrase=\"%s\" match=%+v has wrong offsets", testCase.phrase, match)
+			}
+		}
+	}
+}
+
+func TestRedactPIIMatches(t *testing.T) {
+	text := "Email jоhn@gmail.com, card 4111 1111 1111 1111"
+	_, matches := RedactPII(text)
+	expected := []PIIMatch{
+		{Start: 6, End: 21, Text: "jоhn@gmail.com", Kind: PIIEmail},
+		{Start: 28, End: 47, Text: "4111 1111 1111 1111", Kind: PIICard},
+	}
+	if len(matches) != len(expected) {
+		t.Fatalf("matches=%+v expected=%+v", matches, expected)
+	}
+	for i := range matches {
+		if matches[i] != expected[i] {
+			t.Errorf("match=%+v expected=%+v", matches[i], expected[i])
+		}
+	}
+}
+
+func ExampleRedactPII() {
+	redacted, matches := RedactPII("Reach me at john@example.com or 555-123-4567")
+	fmt.Println(redacted)
+	for _, match := range matches {
+		fmt.Println(match.Kind, match.Text)
+	}
+	// Output:
+	// Reach me at [email] or [phone]
+	// email john@example.com
+	// phone 555-123-4567
+}
@@ -0,0 +1,170 @@
+package moderation
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io/ioutil"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+// ReloadingFilter is a Filter whose dictionary is loaded from a wordlist file
+// (see LoadWordlist), and reloaded whenever the file changes
+//
+// Each reload builds a new Filter and swaps it in atomically, so scans that
+// are in progress finish with the old dictionary and later scans use the new
+// one. If the file can't be loaded, the last dictionary that was loaded
+// successfully is kept. A ReloadingFilter is safe for concurrent use.
+type ReloadingFilter struct {
+	path    string
+	onError func(error)
+	filter  atomic.Value // *Filter
+
+	// The file that was last loaded, or failed to load
+	mu      sync.Mutex
+	modTime time.Time
+	size    int64
+	hash    [sha256.Size]byte
+	racy    bool // whether a later change might keep the same modTime
+
+	stop     chan struct{}
+	stopOnce sync.Once
+	done     chan struct{}
+}
+
+// NewReloadingFilter loads the wordlist file at path, and then checks it for
+// changes every interval, or never if interval is not positive
+//
+// The file is checked by its modification time and size, and only reloaded
+// if its contents changed. If the file was loaded within a few seconds of
+// being modified, it is also checked by its contents, as file systems may
+// record modification times at a granularity of up to 2 seconds. Any error reloading it is passed to onError, if it
+// is not nil. Close must be called to stop checking for changes.
+func NewReloadingFilter(path string, interval time.Duration, onError func(error)) (*ReloadingFilter, error) {
+	filter := &ReloadingFilter{
+		path:    path,
+		onError: onError,
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	if _, err := filter.reload(true); err != nil {
+		return nil, err
+	}
+	if interval > 0 {
+		go filter.poll(interval)
+	} else {
+		close(filter.done)
+	}
+	return filter, nil
+}
+
+// Filter returns the Filter with the current dictionary, which must not be
+// modified
+//
+// The same Filter may be used for multiple scans so that they see the same
+// dictionary, even if it is reloaded in the meantime.
+func (filter *ReloadingFilter) Filter() *Filter {
+	return filter.filter.Load().(*Filter)
+}
+
+// Scan is like Filter.Scan, using the current dictionary
+func (filter *ReloadingFilter) Scan(text string, opts ...Option) Type {
+	return filter.Filter().Scan(text, opts...)
+}
+
+// IsInappropriate is like Filter.IsInappropriate, using the current dictionary
+func (filter *ReloadingFilter) IsInappropriate(text string) bool {
+	return filter.Filter().IsInappropriate(text)
+}
+
+// ScanDetailed is like Filter.ScanDetailed, using the current dictionary
+func (filter *ReloadingFilter) ScanDetailed(text string, opts ...Option) Details {
+	return filter.Filter().ScanDetailed(text, opts...)
+}
+
+// Censor is like Filter.Censor, using the current dictionary
+func (filter *ReloadingFilter) Censor(text string, types Type, opts ...Option) (censoredText string, replaced int) {
+	return filter.Filter().Censor(text, types, opts...)
+}
+
+// Reload checks the file for changes immediately, returning whether the
+// dictionary was reloaded, or any error loading it
+//
+// Unlike errors found while checking every interval, the error is not passed
+// to onError.
+func (filter *ReloadingFilter) Reload() (bool, error) {
+	return filter.reload(false)
+}
+
+// Close stops checking the file for changes, after which the current
+// dictionary remains in use
+func (filter *ReloadingFilter) Close() error {
+	filter.stopOnce.Do(func() {
+		close(filter.stop)
+	})
+	<-filter.done
+	return nil
+}
+
+// poll checks the file for changes every interval until stopped
+func (filter *ReloadingFilter) poll(interval time.Duration) {
+	defer close(filter.done)
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-filter.stop:
+			return
+		case <-ticker.C:
+			if _, err := filter.reload(false); err != nil && filter.onError != nil {
+				filter.onError(err)
+			}
+		}
+	}
+}
+
+// modTimeGranularity is the coarsest granularity of modification times among
+// common file systems (FAT)
+const modTimeGranularity = 2 * time.Second
+
+// reload loads the file if it changed since it was last loaded (or failed to
+// load), or unconditionally if initial
+func (filter *ReloadingFilter) reload(initial bool) (bool, error) {
+	filter.mu.Lock()
+	defer filter.mu.Unlock()
+
+	now := time.Now()
+	info, err := os.Stat(filter.path)
+	if err != nil {
+		return false, err
+	}
+	if !initial && !filter.racy && info.ModTime().Equal(filter.modTime) && info.Size() == filter.size {
+		return false, nil
+	}
+
+	buf, err := ioutil.ReadFile(filter.path)
+	if err != nil {
+		return false, err
+	}
+	hash := sha256.Sum256(buf)
+	unchanged := !initial && hash == filter.hash
+
+	// Either way, the same file isn't loaded (or reported) again
+	filter.modTime = info.ModTime()
+	filter.size = info.Size()
+	filter.hash = hash
+	filter.racy = now.Sub(info.ModTime()) < modTimeGranularity
+	if unchanged {
+		return false, nil
+	}
+
+	loaded, err := LoadWordlist(bytes.NewReader(buf))
+	if err != nil {
+		return false, err
+	}
+	filter.filter.Store(loaded)
+	return true, nil
+}
@@ -0,0 +1,203 @@
+package moderation
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+)
+
+// writes is the number of wordlist files written by tests
+var writes int64
+
+// writeWordlist writes a wordlist file, with a modification time that differs
+// from any previous write
+func writeWordlist(t *testing.T, path, wordlist string) {
+	if err := ioutil.WriteFile(path, []byte(wordlist), 0644); err != nil {
+		t.Fatal(err)
+	}
+	writes++
+	modTime := time.Unix(1600000000+writes, 0)
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestReloadingFilter(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wordlist.csv")
+	writeWordlist(t, path, "word,mean\nnoob,2\n")
+
+	filter, err := NewReloadingFilter(path, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer filter.Close()
+	if !filter.Scan("noob").Is(Mean) || filter.Scan("loser").Is(Mean) {
+		t.Error("wordlist not loaded")
+	}
+
+	// Scans in progress keep using the old dictionary
+	old := filter.Filter()
+	writeWordlist(t, path, "word,mean\nloser,2\n")
+	if reloaded, err := filter.Reload(); !reloaded || err != nil {
+		t.Fatalf("reloaded=%v err=%v", reloaded, err)
+	}
+	if filter.Scan("noob").Is(Mean) || !filter.Scan("loser").Is(Mean) {
+		t.Error("wordlist not reloaded")
+	}
+	if !old.Scan("noob").Is(Mean) {
+		t.Error("old dictionary was modified")
+	}
+
+	// Unchanged files aren't reloaded, even if touched
+	if reloaded, err := filter.Reload(); reloaded || err != nil {
+		t.Errorf("reloaded=%v err=%v for unchanged file", reloaded, err)
+	}
+	writeWordlist(t, path, "word,mean\nloser,2\n")
+	if reloaded, err := filter.Reload(); reloaded || err != nil {
+		t.Errorf("reloaded=%v err=%v for touched file", reloaded, err)
+	}
+
+	// The last good dictionary is kept
+	writeWordlist(t, path, "word,mean\nloser\n")
+	if reloaded, err := filter.Reload(); reloaded || err == nil {
+		t.Errorf("reloaded=%v err=%v for invalid file", reloaded, err)
+	}
+	if !filter.Scan("loser").Is(Mean) {
+		t.Error("last good dictionary not kept")
+	}
+	if err := os.Remove(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := filter.Reload(); err == nil {
+		t.Error("expected error for missing file")
+	}
+	if !filter.Scan("loser").Is(Mean) {
+		t.Error("last good dictionary not kept")
+	}
+
+	if _, err := NewReloadingFilter(path, 0, nil); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+func TestReloadingFilterSameModTime(t *testing.T) {
+	// A change made soon after the file was loaded is noticed, even if the
+	// file system records the same modification time and the size is the same
+	path := filepath.Join(t.TempDir(), "wordlist.csv")
+	modTime := time.Now().Truncate(time.Second)
+	write := func(wordlist string) {
+		if err := ioutil.WriteFile(path, []byte(wordlist), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.Chtimes(path, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	write("word,mean\nnoob,2\n")
+
+	filter, err := NewReloadingFilter(path, 0, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer filter.Close()
+
+	write("word,mean\nnewb,2\n")
+	if reloaded, err := filter.Reload(); !reloaded || err != nil {
+		t.Fatalf("reloaded=%v err=%v", reloaded, err)
+	}
+	if filter.Scan("noob").Is(Mean) || !filter.Scan("newb").Is(Mean) {
+		t.Error("wordlist not reloaded")
+	}
+	if reloaded, err := filter.Reload(); reloaded || err != nil {
+		t.Errorf("reloaded=%v err=%v for unchanged file", reloaded, err)
+	}
+}
+
+func TestReloadingFilterPoll(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wordlist.csv")
+	writeWordlist(t, path, "word,mean\nnoob,2\n")
+
+	errs := make(chan error, 10)
+	filter, err := NewReloadingFilter(path, time.Millisecond, func(err error) {
+		errs <- err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer filter.Close()
+
+	// Scan concurrently with reloads
+	var wg sync.WaitGroup
+	stop := make(chan struct{})
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for {
+				select {
+				case <-stop:
+					return
+				default:
+					filter.Scan("you noob loser")
+				}
+			}
+		}()
+	}
+	defer func() {
+		close(stop)
+		wg.Wait()
+	}()
+
+	writeWordlist(t, path, "word,mean\nloser,2\n")
+	deadline := time.Now().Add(5 * time.Second)
+	for !filter.Scan("loser").Is(Mean) {
+		if time.Now().After(deadline) {
+			t.Fatal("wordlist not reloaded")
+		}
+		time.Sleep(time.Millisecond)
+	}
+
+	writeWordlist(t, path, "word,mean\nloser,x\n")
+	select {
+	case err := <-errs:
+		if err == nil {
+			t.Error("expected error")
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("error not reported")
+	}
+	if !filter.Scan("loser").Is(Mean) {
+		t.Error("last good dictionary not kept")
+	}
+
+	filter.Close()
+	writeWordlist(t, path, "word,mean\nnoob,2\n")
+	time.Sleep(10 * time.Millisecond)
+	if filter.Scan("noob").Is(Mean) {
+		t.Error("wordlist reloaded after close")
+	}
+}
+
+func ExampleNewReloadingFilter() {
+	dir, err := ioutil.TempDir("", "moderation")
+	if err != nil {
+		panic(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "wordlist.csv")
+	ioutil.WriteFile(path, []byte("word,mean\nnoob,2\n"), 0644)
+
+	filter, err := NewReloadingFilter(path, time.Minute, func(err error) {
+		fmt.Println("failed to reload wordlist:", err)
+	})
+	if err != nil {
+		panic(err)
+	}
+	defer filter.Close()
+	fmt.Println(filter.Scan("noob").Is(Mean))
+	// Output: true
+}
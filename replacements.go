@@ -1,5 +1,7 @@
 package moderation
 
+import "unicode"
+
 var (
 	// Replace the key with any one of the characters in the value
 	replacements = [...]string{
@@ -160,3 +162,13 @@ var (
 		'୨': "g",
 	}
 )
+
+// runeReplacement returns the characters that a rune outside of ASCII may be
+// replaced with, looking it up in lowercase if it isn't found as is
+func runeReplacement(textRune rune) string {
+	replacement := runeReplacements[textRune]
+	if replacement == "" {
+		replacement = runeReplacements[unicode.ToLower(textRune)]
+	}
+	return replacement
+}
@@ -0,0 +1,641 @@
+package moderation
+
+import (
+	"github.com/finnbear/moderation/internal/radix"
+	"golang.org/x/text/unicode/norm"
+	"unicode"
+	"unicode/utf8"
+)
+
+// scanState is the state of a scan in progress, which is fed one rune at a
+// time
+type scanState struct {
+	filter     *Filter
+	thresholds *[countableTypes]thresholds // those of the filter, unless overridden
+
+	// Scan status
+	matches             radix.Queue
+	countableTypeLevels [countableTypes]int
+	falsePositiveLevels [countableTypes]int
+	aggregator          aggregator
+	separate            bool // whether the previous character was a separator
+	found               [maxFound]*radix.Node
+	foundCount          int
+	lastMatchable       byte
+	end                 int // end of the last rune, in the original text
+	forgetAt            int // offset at which matches are next shortened
+
+	// For spam and contact information detection purposes, unless signals is
+	// false (e.g. if only Inappropriate is needed)
+	signals bool
+	spam    spamAnalyzer
+	contact contactAnalyzer
+
+	// If any words are allowed, hits can only be counted once it is known
+	// they are not inside an allowed span
+	allowing     bool
+	hits         []hit
+	allowedSpans []span
+
+	// Called with each hit that is counted, if not nil
+	onHit func(hit)
+}
+
+// span is a range of byte offsets into the original text, [start, end)
+type span struct {
+	start, end int
+}
+
+// within returns whether the span lies fully inside any of the given spans
+func (s span) within(spans []span) bool {
+	for _, other := range spans {
+		if other.start <= s.start && s.end <= other.end {
+			return true
+		}
+	}
+	return false
+}
+
+// hit is a dictionary word found in the text
+type hit struct {
+	span
+	node     *radix.Node
+	replaced bool // whether a replacement or masking character contributed
+	masked   bool // whether a masking character contributed
+	separate bool // whether the word started after a separator
+}
+
+// maxFound is the number of words ending at a single character that are
+// remembered, beyond which the same word may be counted more than once
+const maxFound = 16
+
+// levels returns the amount the hit contributes to each countable type
+func (hit hit) levels() (levels [countableTypes]int) {
+	for i, level := range hit.node.Data() {
+		// False positives that contain masking characters are not matched,
+		// as they may stand for any letter
+		if level > 0 || !hit.masked {
+			levels[i] = int(level)
+		}
+	}
+	return
+}
+
+func (state *scanState) init(filter *Filter, options options) {
+	state.filter = filter
+	state.thresholds = &filter.thresholds
+	if options.overridden {
+		state.thresholds = new([countableTypes]thresholds)
+		for i := range state.thresholds {
+			state.thresholds[i] = options.thresholds
+		}
+	}
+	state.aggregator.aggregation = filter.aggregation
+	state.separate = true
+	state.forgetAt = radix.MaxSpan / 2
+	state.signals = true
+	state.allowing = filter.automaton().Allows()
+	state.reset()
+}
+
+// reset discards all matches, such that words may only start at the next
+// character
+func (state *scanState) reset() {
+	state.matches.Clear()
+	state.matches.Append(radix.Match{Node: state.filter.tree.Root()})
+}
+
+// scanString scans text, the first byte of which is at the given offset in
+// the original text
+func (state *scanState) scanString(text string, offset int) {
+	for i := 0; i < len(text); {
+		start := offset + i
+		textRune, size := rune(text[i]), 1
+		if textRune >= minNormal && textRune <= maxNormal {
+			state.signal(textRune, nil)
+			state.scanRune(textRune, start, start+1)
+			i++
+			continue
+		} else if textRune >= utf8.RuneSelf {
+			textRune, size = utf8.DecodeRuneInString(text[i:])
+		}
+		decomposition := norm.NFD.PropertiesString(text[i : i+size]).Decomposition()
+		state.signal(textRune, decomposition)
+		state.sanitizeRune(textRune, decomposition, start, start+size)
+		i += size
+	}
+}
+
+// signal adds a rune of the original text, with the given decomposition, to
+// the spam and contact information analyzers, unless neither is needed
+func (state *scanState) signal(textRune rune, decomposition []byte) {
+	if state.signals {
+		state.spam.add(textRune)
+		state.contact.add(textRune, decomposition)
+	}
+}
+
+// scanBytes is like scanString, but for a slice of bytes
+func (state *scanState) scanBytes(text []byte, offset int) {
+	for i := 0; i < len(text); {
+		start := offset + i
+		textRune, size := rune(text[i]), 1
+		if textRune >= minNormal && textRune <= maxNormal {
+			state.signal(textRune, nil)
+			state.scanRune(textRune, start, start+1)
+			i++
+			continue
+		} else if textRune >= utf8.RuneSelf {
+			textRune, size = utf8.DecodeRune(text[i:])
+		}
+		decomposition := norm.NFD.Properties(text[i : i+size]).Decomposition()
+		state.signal(textRune, decomposition)
+		state.sanitizeRune(textRune, decomposition, start, start+size)
+		i += size
+	}
+}
+
+// sanitizeRune removes accents from a rune with the given decomposition,
+// while keeping track of where in the original text it came from
+func (state *scanState) sanitizeRune(textRune rune, decomposition []byte, start, end int) {
+	runes, count := unaccented(textRune, decomposition)
+	for _, unaccentedRune := range runes[:count] {
+		state.scanRune(unaccentedRune, start, end)
+	}
+}
+
+// maxDecomposition is the greatest number of runes that the canonical
+// decomposition of a rune has
+const maxDecomposition = 4
+
+// unaccented returns the runes of the given decomposition of a rune (see
+// norm.Properties), or of the rune itself if there is none, without accents
+// (nonspacing marks)
+func unaccented(textRune rune, decomposition []byte) (runes [maxDecomposition]rune, count int) {
+	if decomposition == nil {
+		if !unicode.Is(unicode.Mn, textRune) {
+			runes[0] = textRune
+			count = 1
+		}
+		return
+	}
+	for len(decomposition) > 0 && count < len(runes) {
+		decomposedRune, decomposedSize := utf8.DecodeRune(decomposition)
+		decomposition = decomposition[decomposedSize:]
+		if !unicode.Is(unicode.Mn, decomposedRune) {
+			runes[count] = decomposedRune
+			count++
+		}
+	}
+	return
+}
+
+// scanRune scans a sanitized rune that came from [start, end) of the
+// original text
+func (state *scanState) scanRune(textRune rune, start, end int) {
+	textByte := byte(textRune)
+	var textBytes string
+
+	matchable := false
+	replaced := false
+	skippable := false
+
+	var replacement string
+	if int(textByte) < len(replacements) {
+		replacement = replacements[textByte]
+	} else if textRune > maxNormal {
+		replacement = runeReplacement(textRune)
+	}
+
+	switch {
+	case textByte >= 'a' && textByte <= 'z': // most likely case
+		matchable = true
+	case textByte >= 'A' && textByte <= 'Z':
+		textByte += 'a' - 'A'
+		matchable = true
+	case replacement != "": // if there is a valid set of replacements
+		textByte = replacement[0]
+		textBytes = replacement
+		matchable = true
+		replaced = true
+	default:
+		// matchable = false implied
+		if textByte == '*' { // these count as replacements
+			replaced = true
+			skippable = true
+		} else if isSeparator(textByte) {
+			skippable = true
+		}
+	}
+
+	state.end = end
+	state.foundCount = 0
+	if start >= state.forgetAt {
+		// Words can't span radix.MaxSpan bytes or more, so that matches
+		// needn't remember the full index of each letter
+		state.forget(start - radix.MaxSpan/2)
+		state.forgetAt = start + radix.MaxSpan/2
+	}
+	if textRune != '*' && !(textRune >= 'a' && textRune <= 'z') && !(textRune >= 'A' && textRune <= 'Z') && (textRune < utf8.RuneSelf || replacement == "") {
+		// Any character other than a letter may complete a phrase, even if
+		// it may also stand for a letter (e.g. "go die!")
+		state.boundary(start)
+	}
+
+	matches := &state.matches
+
+	if matchable {
+		if textByte == state.lastMatchable {
+			state.spam.repetitionCount++
+		}
+
+		// Process textBytes as multiple textBytes or textByte
+		loops := 1
+		if len(textBytes) > 1 {
+			loops = len(textBytes)
+		}
+
+		// Each match is replaced by the matches it advances to, which are
+		// only compared to each other. It is removed first, so that if the
+		// queue is full, at least the first of them fits, and words may
+		// still be matched from the next character.
+		originalLength := matches.Len()
+		for m := 0; m < originalLength; m++ {
+			match := matches.Remove()
+			remaining := originalLength - m - 1
+			if matches.Len()+loops >= radix.QueueSize-1 {
+				// The queue may fill up, after which the match would be
+				// overwritten, so it must be copied first
+				removed := *match
+				match = &removed
+			}
+
+			// Technically should compare to previous byte of given match,
+			// but this would be slower and give similar results for the
+			// given replacements
+			if textByte == state.lastMatchable && match.Node.Depth() > 0 {
+				// Undo remove
+				matches.AppendUnique(match, remaining)
+			}
+
+			for l := 0; l < loops; l++ {
+				loopTextByte := textByte
+				if len(textBytes) > 0 {
+					loopTextByte = textBytes[l]
+				}
+
+				// Only allowed words may be matched after a wildcard, so
+				// such matches can't fall back to shorter words
+				var next *radix.Node
+				if match.AllowOnly {
+					next = match.Node.Next(loopTextByte)
+					if next == nil || !next.Allows() {
+						continue
+					}
+				} else {
+					next = match.Node.Follow(loopTextByte)
+				}
+
+				advanced, unique := matches.Advance(match, next, start, state.separate, replaced, remaining)
+				if advanced == nil {
+					continue
+				}
+				if next.Outputs() {
+					state.output(advanced, end, false, &state.allowedSpans, state.hit)
+				}
+				if unique {
+					matches.Commit()
+				}
+			}
+		}
+
+		state.lastMatchable = textByte
+	} else if skippable {
+		originalLength := matches.Len()
+		for m := 0; m < originalLength; m++ {
+			match := matches.At(m)
+			match.Skip(replaced)
+
+			// A masked character may stand for any letter of an allowed word
+			if state.allowing && replaced {
+				for node := match.Node; node.Depth() > 0; node = node.Fail() {
+					if node.Allows() {
+						state.wildcard(match, node, start, end)
+					}
+					if match.AllowOnly {
+						break
+					}
+				}
+			}
+		}
+	} else {
+		state.reset()
+	}
+
+	state.separate = skippable || !matchable
+
+	if len(state.hits) > 0 {
+		state.resolve()
+	}
+}
+
+// wildcard advances a match by each letter that a masked character at [start,
+// end) may stand for to continue an allowed word from node, which is the node
+// of the match or one of its suffixes
+func (state *scanState) wildcard(match *radix.Match, node *radix.Node, start, end int) {
+	for _, next := range node.Children() {
+		if !next.Allows() {
+			continue
+		}
+		advanced := *match
+		advanced.AllowOnly = true
+		advanced.Consume(next, start, false, true)
+		if next.Allowed() {
+			state.allowedSpans = append(state.allowedSpans, span{start: advanced.Start(next.Depth()), end: end})
+		}
+		state.matches.AppendUnique(&advanced, 0)
+	}
+}
+
+// output finds the words and allowed words that a match ends with, which
+// just consumed a letter, or a word boundary if phrase, ending at end
+//
+// Allowed words are appended to allowed, and words are passed to report,
+// unless they were already found ending at the same character.
+func (state *scanState) output(match *radix.Match, end int, phrase bool, allowed *[]span, report func(hit)) {
+	for node := match.Node; node != nil && node.Depth() > 0; node = node.Output() {
+		depth := node.Depth()
+		if phrase && !match.Bounded(depth) {
+			// Phrases must start at a word boundary
+			continue
+		}
+		if state.allowing && node.Allowed() {
+			*allowed = append(*allowed, span{start: match.Start(depth), end: end})
+		}
+		if match.AllowOnly {
+			// The match is only for the allowed word it is anchored to
+			break
+		}
+		if !node.Word() {
+			continue
+		}
+		var separate bool
+		if phrase {
+			separate = true
+		} else {
+			separate = match.Separate(depth)
+			if !(depth > 4 || (depth > 3 && node.Start() != 's') || separate) {
+				continue
+			}
+		}
+		if state.find(node) {
+			report(hit{
+				span:     span{start: match.Start(depth), end: end},
+				node:     node,
+				replaced: match.Replaced(depth),
+				masked:   match.Masked(depth),
+				separate: separate,
+			})
+		}
+	}
+}
+
+// find remembers that a word was found, returning false if it was already
+// found ending at the current character (e.g. "ffuck" starting at either "f")
+func (state *scanState) find(node *radix.Node) bool {
+	for _, found := range state.found[:state.foundCount] {
+		if found == node {
+			return false
+		}
+	}
+	if state.foundCount < len(state.found) {
+		state.found[state.foundCount] = node
+		state.foundCount++
+	}
+	return true
+}
+
+// boundary advances matches past a word boundary that ends at the given
+// offset, counting any phrases that it completes
+func (state *scanState) boundary(end int) {
+	state.completions(end, true, &state.allowedSpans, state.hit)
+}
+
+// completions advances each match that can be past a word boundary at the
+// given offset, and outputs the phrases that it completes (see output), then
+// keeps the advanced match if keep
+//
+// The matches themselves are kept, as the boundary may be skipped.
+func (state *scanState) completions(end int, keep bool, allowed *[]span, report func(hit)) {
+	matches := &state.matches
+	originalLength := matches.Len()
+	for m := 0; m < originalLength; m++ {
+		match := matches.At(m)
+
+		var next *radix.Node
+		if match.AllowOnly {
+			next = match.Node.Boundary()
+			if next == nil || !next.Allows() {
+				continue
+			}
+		} else {
+			if !match.Node.Bounds() {
+				continue
+			}
+			next = match.Node.Follow(' ')
+		}
+		advanced, unique := matches.Advance(match, next, end, false, false, 0)
+		if advanced == nil {
+			continue
+		}
+		state.output(advanced, end, true, allowed, report)
+		if keep && unique {
+			matches.Commit()
+		}
+	}
+}
+
+// resolve counts any held hits that can no longer be inside an allowed span,
+// so that they don't build up when scanning a long stream of text
+func (state *scanState) resolve() {
+	// Any allowed span that is yet to be found will start at or after the
+	// start of a match that can still become an allowed word
+	earliest := -1
+	for m := 0; m < state.matches.Len(); m++ {
+		match := state.matches.At(m)
+		// The longest suffix that can become an allowed word starts first
+		for node := match.Node; node.Depth() > 0; node = node.Fail() {
+			if node.Allows() {
+				if start := match.Start(node.Depth()); earliest == -1 || start < earliest {
+					earliest = start
+				}
+				break
+			}
+			if match.AllowOnly {
+				break
+			}
+		}
+	}
+
+	held := state.hits[:0]
+	for _, hit := range state.hits {
+		if earliest != -1 && hit.start >= earliest {
+			held = append(held, hit)
+		} else if !hit.within(state.allowedSpans) {
+			state.count(hit)
+		}
+	}
+	state.hits = held
+
+	// Any hit that is yet to be found will end after every allowed span that
+	// was already found
+	if len(state.hits) == 0 {
+		state.allowedSpans = state.allowedSpans[:0]
+	}
+}
+
+// forget shortens every match to the longest suffix that starts at or after
+// before, so that no hit that is yet to be found starts before it (e.g. so
+// that a stream of separators after a letter can't be held back forever)
+func (state *scanState) forget(before int) {
+	for m, n := 0, state.matches.Len(); m < n; m++ {
+		match := state.matches.Remove()
+		for match.Node.Depth() > 0 && match.Start(match.Node.Depth()) < before {
+			match.Fail()
+		}
+		if match.Node.Depth() > 0 || !match.AllowOnly {
+			state.matches.AppendUnique(match, n-m-1)
+		}
+	}
+	if len(state.hits) > 0 {
+		state.resolve()
+	}
+}
+
+// hit counts a hit, or holds onto it until the end of the text if it might
+// turn out to be inside an allowed span
+func (state *scanState) hit(hit hit) {
+	if state.allowing {
+		state.hits = append(state.hits, hit)
+	} else {
+		state.count(hit)
+	}
+}
+
+func (state *scanState) count(hit hit) {
+	levels := hit.levels()
+	for i, level := range levels {
+		state.countableTypeLevels[i] += level
+		if level < 0 {
+			state.falsePositiveLevels[i] += level
+		}
+	}
+	state.aggregator.add(hit, levels)
+	if state.onHit != nil {
+		state.onHit(hit)
+	}
+}
+
+// finish counts any remaining hits and returns the scan result
+func (state *scanState) finish() Type {
+	// The end of the text is a word boundary
+	state.foundCount = 0
+	state.boundary(state.end)
+	state.reset()
+
+	for _, hit := range state.hits {
+		if !hit.within(state.allowedSpans) {
+			state.count(hit)
+		}
+	}
+	state.hits = state.hits[:0]
+	state.allowedSpans = state.allowedSpans[:0]
+
+	// Nothing is left for details to count
+	return state.scoresType(state.aggregator.total(state.spam.length))
+}
+
+// result returns the scan result so far, without counting any remaining hits
+// such that more text may be scanned
+func (state *scanState) result() Type {
+	return state.details().Type
+}
+
+// details is like result, but returns the details of the scan result
+func (state *scanState) details() (details Details) {
+	details.Levels = state.countableTypeLevels
+	details.FalsePositives = state.falsePositiveLevels
+	aggregator := state.aggregator
+	aggregator.seen = aggregator.seen[:len(aggregator.seen):len(aggregator.seen)]
+
+	// Held hits, as well as phrases that the end of the text would complete
+	hits := state.hits[:len(state.hits):len(state.hits)]
+	allowedSpans := state.allowedSpans[:len(state.allowedSpans):len(state.allowedSpans)]
+	state.foundCount = 0
+	state.completions(state.end, false, &allowedSpans, func(hit hit) {
+		hits = append(hits, hit)
+	})
+
+	for _, hit := range hits {
+		if !hit.within(allowedSpans) {
+			levels := hit.levels()
+			for i, level := range levels {
+				details.Levels[i] += level
+				if level < 0 {
+					details.FalsePositives[i] += level
+				}
+			}
+			aggregator.add(hit, levels)
+		}
+	}
+	details.Scores = aggregator.total(state.spam.length)
+	details.Type = state.scoresType(details.Scores)
+	details.SpamPercent = state.spam.percent()
+
+	return
+}
+
+// scoresType returns the scan result given the scores of the countable types
+func (state *scanState) scoresType(scores [countableTypes]int) Type {
+	result := levelsType(scores, state.thresholds)
+	if !state.signals {
+		return result
+	}
+	if severity := state.spam.severity(); severity > 0 {
+		result |= Spam & severityType(severity)
+	}
+	if severity := state.contact.severity(); severity > 0 {
+		result |= ContactInfo & severityType(severity)
+	}
+	return result
+}
+
+// severityType returns the bits of every type for a severity from 1 (mild) to
+// 3 (severe), which is different from Mild, Moderate, and Severe in that only
+// one bit is set per type
+func severityType(severity int) Type {
+	return severityBits << (severity - 1)
+}
+
+// levelsType returns the severity of each countable type given its level
+func levelsType(levels [countableTypes]int, thresholds *[countableTypes]thresholds) (result Type) {
+	if levels == [countableTypes]int{} {
+		// Most text has no levels, which are below any valid mild threshold
+		return
+	}
+	for i, level := range levels {
+		if level < thresholds[i][0] {
+			continue
+		}
+
+		severity := Type(0b001) // mild
+		if level >= thresholds[i][2] {
+			severity = 0b100 // severe
+		} else if level >= thresholds[i][1] {
+			severity = 0b010 // moderate
+		}
+
+		result |= severity << (i * 3)
+	}
+	return
+}
@@ -0,0 +1,145 @@
+package moderation
+
+import (
+	"io"
+	"unicode/utf8"
+)
+
+// Scanner scans text that is written to it incrementally, such as text read
+// from a network connection or a large file, without holding onto it
+//
+// Words that are split between writes are still detected, as the state of the
+// scan carries over from one write to the next. A Scanner must not be used
+// concurrently.
+type Scanner struct {
+	state   scanState
+	options options
+	offset  int // number of bytes scanned so far
+
+	// A rune that was split between writes
+	partial     [utf8.UTFMax]byte
+	partialSize int
+}
+
+// NewScanner returns a Scanner that uses the default dictionary
+//
+// Equivalent to calling NewScanner on a Filter returned by NewFilter
+func NewScanner(opts ...Option) *Scanner {
+	return defaultFilter().NewScanner(opts...)
+}
+
+// NewScanner returns a Scanner that uses the filter's dictionary
+//
+// The filter must not be modified while the Scanner is in use.
+func (filter *Filter) NewScanner(opts ...Option) *Scanner {
+	scanner := &Scanner{options: newOptions(opts)}
+	scanner.state.init(filter, scanner.options)
+	return scanner
+}
+
+// Write scans p as the continuation of any text written so far, and always
+// returns len(p), nil
+func (scanner *Scanner) Write(p []byte) (int, error) {
+	n := len(p)
+
+	// Finish a rune that was split between writes
+	if scanner.partialSize > 0 {
+		for len(p) > 0 && !utf8.FullRune(scanner.partial[:scanner.partialSize]) {
+			scanner.partial[scanner.partialSize] = p[0]
+			scanner.partialSize++
+			p = p[1:]
+		}
+		if !utf8.FullRune(scanner.partial[:scanner.partialSize]) {
+			return n, nil
+		}
+		scanner.scan(scanner.partial[:scanner.partialSize])
+		scanner.partialSize = 0
+	}
+
+	// Hold back a rune that is split between this write and the next
+	end := len(p)
+	for i := len(p) - 1; i >= 0 && i >= len(p)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(p[i]) {
+			if !utf8.FullRune(p[i:]) {
+				end = i
+			}
+			break
+		}
+	}
+	scanner.scan(p[:end])
+	scanner.partialSize = copy(scanner.partial[:], p[end:])
+
+	return n, nil
+}
+
+// WriteString is like Write, but for a string
+func (scanner *Scanner) WriteString(s string) (int, error) {
+	if scanner.partialSize > 0 || !utf8.FullRuneInString(s[lastRuneStart(s):]) {
+		return scanner.Write([]byte(s))
+	}
+	scanner.state.scanString(s, scanner.offset)
+	scanner.offset += len(s)
+	return len(s), nil
+}
+
+// ReadFrom scans all the text read from r until io.EOF, returning the number of
+// bytes read and any error other than io.EOF
+func (scanner *Scanner) ReadFrom(r io.Reader) (n int64, err error) {
+	var buf [4096]byte
+	for {
+		read, readErr := r.Read(buf[:])
+		scanner.Write(buf[:read])
+		n += int64(read)
+		if readErr == io.EOF {
+			return n, nil
+		} else if readErr != nil {
+			return n, readErr
+		}
+	}
+}
+
+// Result returns a bitmask of all types detected within the text written so
+// far, which can be queried with the Is function
+//
+// Writing more text after calling Result is allowed.
+func (scanner *Scanner) Result() Type {
+	return scanner.state.result()
+}
+
+// Reset discards the text written so far, so that the Scanner may be reused
+func (scanner *Scanner) Reset() {
+	filter := scanner.state.filter
+	*scanner = Scanner{options: scanner.options}
+	scanner.state.init(filter, scanner.options)
+}
+
+func (scanner *Scanner) scan(p []byte) {
+	scanner.state.scanBytes(p, scanner.offset)
+	scanner.offset += len(p)
+}
+
+// lastRuneStart returns the index of the start of the last rune in s
+func lastRuneStart(s string) int {
+	for i := len(s) - 1; i >= 0 && i >= len(s)-utf8.UTFMax; i-- {
+		if utf8.RuneStart(s[i]) {
+			return i
+		}
+	}
+	return 0
+}
+
+// ScanReader returns a bitmask of all types detected within the text read from
+// r until io.EOF, without reading it all into memory
+//
+// Equivalent to calling ScanReader on a Filter returned by NewFilter
+func ScanReader(r io.Reader, opts ...Option) (Type, error) {
+	return defaultFilter().ScanReader(r, opts...)
+}
+
+// ScanReader returns a bitmask of all types detected within the text read from
+// r until io.EOF, without reading it all into memory
+func (filter *Filter) ScanReader(r io.Reader, opts ...Option) (Type, error) {
+	scanner := filter.NewScanner(opts...)
+	_, err := scanner.ReadFrom(r)
+	return scanner.Result(), err
+}
@@ -0,0 +1,80 @@
+package moderation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"testing/iotest"
+)
+
+func TestScannerSplit(t *testing.T) {
+	phrases := []string{
+		"hello there",
+		"what the fuck",
+		"what the fučk",
+		"what the f*u*c*k",
+		"you're a dumbass",
+		"assassin",
+		"βιτ⊂η please",
+		"HELLO THERE",
+		"I live in Scunthorpe",
+	}
+	for _, phrase := range phrases {
+		expected := Scan(phrase)
+
+		// Split the phrase at every byte, including in the middle of runes
+		for i := 0; i <= len(phrase); i++ {
+			scanner := NewScanner()
+			scanner.Write([]byte(phrase[:i]))
+			scanner.Write([]byte(phrase[i:]))
+			if result := scanner.Result(); result != expected {
+				t.Errorf("phrase=\"%s\" split at %d result=%b expected=%b", phrase, i, result, expected)
+			}
+
+			scanner.Reset()
+			scanner.WriteString(phrase[:i])
+			scanner.WriteString(phrase[i:])
+			if result := scanner.Result(); result != expected {
+				t.Errorf("phrase=\"%s\" split string at %d result=%b expected=%b", phrase, i, result, expected)
+			}
+		}
+
+		result, err := ScanReader(iotest.OneByteReader(strings.NewReader(phrase)))
+		if err != nil {
+			t.Error(err)
+		}
+		if result != expected {
+			t.Errorf("phrase=\"%s\" one byte reader result=%b expected=%b", phrase, result, expected)
+		}
+	}
+}
+
+func TestScannerAllow(t *testing.T) {
+	filter := NewFilter()
+	if err := filter.AllowWord("Scunthorpe"); err != nil {
+		t.Fatal(err)
+	}
+
+	scanner := filter.NewScanner()
+	for i := 0; i < 10000; i++ {
+		scanner.WriteString("Scunth")
+		scanner.WriteString("orpe and sh")
+		scanner.WriteString("it happens. ")
+	}
+	if result := scanner.Result(); !result.Is(Profane) || result.Is(Offensive|Sexual) {
+		t.Errorf("result=%b", result)
+	}
+
+	// Hits that can't be allowed anymore shouldn't build up
+	if len(scanner.state.hits) > 1 || len(scanner.state.allowedSpans) > 1 {
+		t.Errorf("%d hits and %d allowed spans held", len(scanner.state.hits), len(scanner.state.allowedSpans))
+	}
+}
+
+func ExampleScanner() {
+	scanner := NewScanner()
+	scanner.Write([]byte("what the sh"))
+	scanner.Write([]byte("1t"))
+	fmt.Println(scanner.Result().Is(Profane))
+	// Output: true
+}
@@ -0,0 +1,262 @@
+package moderation
+
+import (
+	"unicode"
+	"unicode/utf8"
+)
+
+// spamAnalyzer detects spam from a number of signals as text is scanned, each
+// of which has a severity, the greatest of which is the severity of spam
+type spamAnalyzer struct {
+	// Characters, which are runes other than combining marks, such that
+	// accented letters count once whether or not they are precomposed
+	length      int
+	upperCount  int
+	punctuation int
+	emoji       int
+
+	// Repeated letters (counted after sanitization, such that replacements
+	// like "0" for "o" count)
+	repetitionCount int
+
+	// Character floods (e.g. "!!!!!!!!!!!!")
+	lastRune   rune
+	run        int
+	longestRun int
+
+	// Combining marks on a single character (e.g. zalgo text)
+	marks        int
+	longestMarks int
+
+	// Whitespace padding
+	spaceRun        int
+	longestSpaceRun int
+
+	// Repeated words and phrases (e.g. "buy now buy now buy now")
+	word          uint64    // hash of the current word
+	wordLength    int       // runes in the current word
+	recentWords   [4]uint64 // hashes of the most recent words
+	words         int
+	repeatedWords int
+}
+
+// Each signal is Mild, Moderate, or Severe at or above these values
+var (
+	// Percent of uppercase and repeated letters, halved
+	spamPercentThresholds = thresholds{31, 51, 76}
+
+	// Runes in the longest run of the same rune
+	floodThresholds = thresholds{10, 20, 50}
+
+	// Percent of words that repeat one of the few words before them, once
+	// there are enough words
+	repeatedWordsThresholds = thresholds{50, 70, 85}
+	minRepeatedWords        = 10
+
+	// Percent of characters that are punctuation or symbols, once there are
+	// enough characters
+	punctuationThresholds = thresholds{40, 60, 80}
+	minPunctuationLength  = 10
+
+	// Number of emoji, as well as the percent of characters that are emoji
+	emojiThresholds        = thresholds{5, 10, 30}
+	emojiPercentThresholds = thresholds{40, 60, 80}
+
+	// Combining marks on a single character
+	marksThresholds = thresholds{3, 5, 8}
+
+	// Runes in the longest run of whitespace
+	spaceRunThresholds = thresholds{20, 40, 100}
+)
+
+const fnvOffset64 = 14695981039346656037
+const fnvPrime64 = 1099511628211
+
+// add adds a rune of the original text (before sanitization)
+func (spam *spamAnalyzer) add(textRune rune) {
+	if textRune == spam.lastRune {
+		spam.run++
+	} else {
+		spam.lastRune = textRune
+		spam.run = 1
+	}
+	// Runs of whitespace are padding rather than floods (see addSpace)
+	if spam.run > spam.longestRun && !unicode.IsSpace(textRune) {
+		spam.longestRun = spam.run
+	}
+
+	if textRune < utf8.RuneSelf {
+		// Avoid looking up ASCII in the unicode tables
+		spam.length++
+		spam.marks = 0
+		switch {
+		case textRune == ' ' || (textRune >= '\t' && textRune <= '\r'):
+			spam.addSpace()
+		case textRune >= 'A' && textRune <= 'Z':
+			spam.upperCount++
+			spam.addLetter(textRune + 'a' - 'A')
+		case (textRune >= 'a' && textRune <= 'z') || (textRune >= '0' && textRune <= '9'):
+			spam.addLetter(textRune)
+		default:
+			spam.spaceRun = 0
+			spam.endWord()
+			if textRune > ' ' && textRune < utf8.RuneSelf-1 {
+				spam.punctuation++
+			}
+		}
+		return
+	}
+
+	if unicode.In(textRune, unicode.Mn, unicode.Me) {
+		spam.marks++
+		if spam.marks > spam.longestMarks {
+			spam.longestMarks = spam.marks
+		}
+		return
+	}
+	spam.length++
+	spam.marks = 0
+
+	if unicode.IsSpace(textRune) {
+		spam.addSpace()
+		return
+	}
+	spam.spaceRun = 0
+
+	if unicode.IsLetter(textRune) || unicode.IsDigit(textRune) {
+		if unicode.IsUpper(textRune) {
+			spam.upperCount++
+		}
+		spam.addLetter(unicode.ToLower(textRune))
+		return
+	}
+	spam.endWord()
+
+	if isEmoji(textRune) {
+		spam.emoji++
+	} else if unicode.IsPunct(textRune) || unicode.IsSymbol(textRune) {
+		spam.punctuation++
+	}
+}
+
+// addSpace is called with each whitespace rune
+func (spam *spamAnalyzer) addSpace() {
+	spam.spaceRun++
+	if spam.spaceRun > spam.longestSpaceRun {
+		spam.longestSpaceRun = spam.spaceRun
+	}
+	spam.endWord()
+}
+
+// addLetter is called with each lowercase letter or digit, which are part of
+// a word
+func (spam *spamAnalyzer) addLetter(lowerRune rune) {
+	if spam.wordLength == 0 {
+		spam.word = fnvOffset64
+	}
+	spam.word = (spam.word ^ uint64(lowerRune)) * fnvPrime64
+	spam.wordLength++
+}
+
+// endWord is called at the end of each word
+func (spam *spamAnalyzer) endWord() {
+	if spam.wordLength == 0 {
+		return
+	}
+	spam.wordLength = 0
+	spam.words++
+
+	for _, recent := range spam.recentWords {
+		if recent == spam.word {
+			spam.repeatedWords++
+			break
+		}
+	}
+	copy(spam.recentWords[1:], spam.recentWords[:])
+	spam.recentWords[0] = spam.word
+}
+
+// percent returns the percent of characters that are uppercase or repeated
+// letters, halved, or zero if the text is too short to tell
+func (spam *spamAnalyzer) percent() int {
+	// Min length is arbitrary, but must be > 0 to avoid dividing by zero
+	if spam.length > 5 {
+		return (100 / 2) * (spam.upperCount + spam.repetitionCount) / spam.length
+	}
+	return 0
+}
+
+// severity returns the greatest severity of any signal, from 0 (none) to 3
+// (severe)
+func (spam spamAnalyzer) severity() int {
+	// The current word may be the last
+	spam.endWord()
+
+	severities := [...]int{
+		signalSeverity(spam.longestRun, floodThresholds),
+		signalSeverity(spam.longestMarks, marksThresholds),
+		signalSeverity(spam.longestSpaceRun, spaceRunThresholds),
+	}
+
+	severity := 0
+	for _, s := range severities {
+		if s > severity {
+			severity = s
+		}
+	}
+
+	if spam.length > 5 {
+		// Same as the severity of percent
+		if s := percentSeverity(spam.upperCount+spam.repetitionCount, 2*spam.length, spamPercentThresholds); s > severity {
+			severity = s
+		}
+	}
+	if spam.words >= minRepeatedWords {
+		if s := percentSeverity(spam.repeatedWords, spam.words, repeatedWordsThresholds); s > severity {
+			severity = s
+		}
+	}
+	if spam.length >= minPunctuationLength {
+		if s := percentSeverity(spam.punctuation, spam.length, punctuationThresholds); s > severity {
+			severity = s
+		}
+	}
+	if spam.emoji > 0 {
+		// The emoji must also make up most of the text
+		s := signalSeverity(spam.emoji, emojiThresholds)
+		if p := percentSeverity(spam.emoji, spam.length, emojiPercentThresholds); p < s {
+			s = p
+		}
+		if s > severity {
+			severity = s
+		}
+	}
+
+	return severity
+}
+
+// signalSeverity returns the severity of a signal, from 0 (none) to 3 (severe)
+func signalSeverity(value int, thresholds thresholds) int {
+	for i := len(thresholds) - 1; i >= 0; i-- {
+		if value >= thresholds[i] {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// percentSeverity returns the severity of a signal that is the percent of
+// whole that part makes up, rounded down, without dividing
+func percentSeverity(part, whole int, thresholds thresholds) int {
+	for i := len(thresholds) - 1; i >= 0; i-- {
+		if 100*part >= thresholds[i]*whole {
+			return i + 1
+		}
+	}
+	return 0
+}
+
+// isEmoji returns whether r is likely to be an emoji
+func isEmoji(r rune) bool {
+	return (r >= 0x1F000 && r <= 0x1FAFF) || (r >= 0x2600 && r <= 0x27BF) || (r >= 0x2B00 && r <= 0x2BFF)
+}
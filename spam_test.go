@@ -0,0 +1,137 @@
+package moderation
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestSpam(t *testing.T) {
+	// severity is 0 for none, or 1, 2, or 3 for Mild, Moderate, or Severe
+	type TestCase struct {
+		phrase   string
+		severity int
+	}
+	testCases := []TestCase{
+		{"Normal text", 0},
+		{"Hello there, how are you doing today?", 0},
+		{"I'm fine :)", 0},
+		{"HELLO THERE", 1},
+		{"duuuuuuuuumb", 1},
+		{"HELLO THERE EVERYONE", 1},
+		{"AAAAAAAAAAAA", 3},
+
+		// Character floods
+		{"that was amazing" + strings.Repeat("!", 10), 1},
+		{"that was amazing" + strings.Repeat("!", 20), 2},
+		{"that was amazing" + strings.Repeat("!", 50), 3},
+
+		// Repeated words and phrases
+		{"buy now buy now buy now buy now buy now", 2},
+		{"buy now buy now buy now buy now buy now buy now buy now", 3},
+		{"spam spam spam spam spam spam spam spam spam spam", 3},
+		{"one two three four five one", 0},
+		{"buy now buy now buy now", 0},
+		{"ha ha ha ha ha ha", 0},
+
+		// Punctuation
+		{"what?! really?! no way?!", 0},
+		{"hi ?!?#$%&*@!?", 2},
+		{"?!#$%&@?!#$%&@?!#$%&@", 3},
+
+		// Emoji
+		{"nice 👍", 0},
+		{"🎉🎈🎁🎂🥳", 1},
+		{"🎉🎈🎁🎂🥳🎉🎈🎁🎂🥳", 2},
+		{strings.Repeat("🎉🎈🎁", 10), 3},
+		{"we are having a party tonight " + strings.Repeat("🎉🎈", 5), 0},
+
+		// Combining marks (zalgo)
+		{"café", 0},
+		{"hé̂̃llo", 1},
+		{"hé̂̃̄̅llo", 2},
+		{"hé̂̃̄̅̆̇̈llo", 3},
+
+		// Whitespace padding
+		{"hello" + strings.Repeat(" ", 10) + "world", 0},
+		{"hi" + strings.Repeat(" ", 20) + "there", 1},
+		{"hi" + strings.Repeat("\n", 40) + "there", 2},
+		{"hi" + strings.Repeat(" ", 100) + "there", 3},
+	}
+
+	for _, testCase := range testCases {
+		result := Scan(testCase.phrase)
+
+		severity := 0
+		if result.Is(Spam & Severe) {
+			severity = 3
+		} else if result.Is(Spam & Moderate) {
+			severity = 2
+		} else if result.Is(Spam) {
+			severity = 1
+		}
+
+		if severity != testCase.severity {
+			t.Errorf("phrase=\"%s\" severity=%d expected=%d", testCase.phrase, severity, testCase.severity)
+		}
+	}
+}
+
+func TestSpamUnicode(t *testing.T) {
+	// Shouting in other languages and scripts
+	shouting := []string{
+		"ПРИВЕТ ВСЕМ",
+		"ΓΕΙΑ ΣΑΣ ΟΛΟΥΣ",
+		"NÃO ACREDITO",
+		"OLÁ, TUDO BEM?",
+		"ÇA VA TRÈS BIEN",
+		"ÜBER ALLES",
+		"ＨＥＬＬＯ ＴＨＥＲＥ",
+	}
+	for _, phrase := range shouting {
+		if !Scan(phrase).Is(Spam) {
+			t.Errorf("phrase=\"%s\" expected spam", phrase)
+		}
+		if lower := strings.ToLower(phrase); Scan(lower).Is(Spam) {
+			t.Errorf("phrase=\"%s\" expected no spam", lower)
+		}
+	}
+
+	// Multi-byte and decomposed characters count once, so they don't dilute
+	// the percentage
+	percents := [][]string{
+		{"OLA TUDO BEM", "OLÁ TUDO BÉM", "OLA\u0301 TUDO BE\u0301M"},
+		{"HI THERE", "ΓΕΙΑ ΣΑΣ", "ＨＩ ＴＨＥＲＥ"},
+	}
+	for _, phrases := range percents {
+		expected := ScanDetailed(phrases[0]).SpamPercent
+		for _, phrase := range phrases[1:] {
+			if percent := ScanDetailed(phrase).SpamPercent; percent != expected {
+				t.Errorf("phrase=\"%s\" percent=%d expected=%d", phrase, percent, expected)
+			}
+		}
+	}
+}
+
+func TestSpamScanner(t *testing.T) {
+	// Signals carry across writes
+	scanner := NewScanner()
+	for i := 0; i < 10; i++ {
+		scanner.WriteString("buy now ")
+	}
+	if !scanner.Result().Is(Spam & Severe) {
+		t.Errorf("expected severe spam, got %b", scanner.Result())
+	}
+}
+
+func ExampleScan_spam() {
+	fmt.Println(Scan("Hello there").Is(Spam))
+	fmt.Println(Scan("HELLO THERE").Is(Spam))
+	fmt.Println(Scan("buy now buy now buy now buy now buy now").Is(Spam & Moderate))
+	fmt.Println(Scan("AAAAAAAAAAAA").Is(Spam & Severe))
+	// Output:
+	// false
+	// true
+	// true
+	// true
+}
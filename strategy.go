@@ -0,0 +1,90 @@
+package moderation
+
+import "unicode/utf8"
+
+// CensorStrategy returns the text that replaces a match that is censored
+//
+// Overlapping matches are combined into one before being censored.
+type CensorStrategy func(match Match) string
+
+// MaskAll replaces every character of the match with replacement
+func MaskAll(replacement rune) CensorStrategy {
+	return func(match Match) string {
+		return mask(match.Text, replacement, 0, 0)
+	}
+}
+
+// MaskKeepFirst replaces all but the first character of the match with
+// replacement (e.g. "s***")
+func MaskKeepFirst(replacement rune) CensorStrategy {
+	return func(match Match) string {
+		return mask(match.Text, replacement, 1, 0)
+	}
+}
+
+// MaskKeepFirstAndLast replaces all but the first and last characters of the
+// match with replacement (e.g. "s**t")
+func MaskKeepFirstAndLast(replacement rune) CensorStrategy {
+	return func(match Match) string {
+		return mask(match.Text, replacement, 1, 1)
+	}
+}
+
+// Grawlix replaces every character of the match with a symbol from "@#$%!",
+// (e.g. "@#$%")
+func Grawlix() CensorStrategy {
+	const symbols = "@#$%!"
+	return func(match Match) string {
+		buf := make([]byte, utf8.RuneCountInString(match.Text))
+		for i := range buf {
+			buf[i] = symbols[i%len(symbols)]
+		}
+		return string(buf)
+	}
+}
+
+// Token replaces the match with token, regardless of its length (e.g.
+// "[removed]")
+func Token(token string) CensorStrategy {
+	return func(match Match) string {
+		return token
+	}
+}
+
+// ByCategory replaces the match with the text for its most severe type, which
+// is one of Profane, Offensive, Sexual, Mean, Violent, SelfHarm, or Distress,
+// or uses otherwise if there is no text for any of its types
+func ByCategory(replacements map[Type]string, otherwise CensorStrategy) CensorStrategy {
+	return func(match Match) string {
+		best := -1
+		var replacement string
+		for i, level := range match.Levels {
+			category := Type(0b111) << (i * 3)
+			if text, ok := replacements[category]; ok && level > 0 && level > best {
+				best = level
+				replacement = text
+			}
+		}
+		if best == -1 {
+			return otherwise(match)
+		}
+		return replacement
+	}
+}
+
+// mask replaces the characters of text with replacement, except for the
+// given number of characters at the start and end
+func mask(text string, replacement rune, keepStart, keepEnd int) string {
+	count := utf8.RuneCountInString(text)
+	masked := make([]byte, 0, len(text))
+	i := 0
+	for _, textRune := range text {
+		if i < keepStart || i >= count-keepEnd {
+			masked = appendRune(masked, textRune)
+		} else {
+			masked = appendRune(masked, replacement)
+		}
+		i++
+	}
+	return string(masked)
+}
@@ -0,0 +1,144 @@
+package moderation
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"github.com/finnbear/moderation/internal/radix"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// LoadWordlist returns a Filter whose dictionary is read from r, which is in
+// the same format as wordlists.csv
+//
+// The first line is a header, "word" followed by the name of the category
+// (see Category) of each column of levels. Each following line is a word or
+// phrase (see AddPhrase), which may be padded with spaces, followed by its
+// level in each category. Blank lines are ignored. If any line is invalid, an
+// error citing its line number is returned.
+func LoadWordlist(r io.Reader) (*Filter, error) {
+	filter := newFilter()
+	if err := filter.MergeWordlist(r); err != nil {
+		return nil, err
+	}
+	return filter, nil
+}
+
+// MergeWordlist is like LoadWordlist, but adds the words to the dictionary of
+// the filter, replacing the levels of any that are already present
+//
+// If any line is invalid, an error is returned and the dictionary is left
+// unchanged. MergeWordlist must not be called concurrently with any other
+// method of the same Filter.
+func (filter *Filter) MergeWordlist(r io.Reader) error {
+	entries, err := readWordlist(r)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		filter.tree.Add(entry.word, entry.levels)
+	}
+	filter.changed()
+	return nil
+}
+
+// wordlistEntry is a word or phrase read from a wordlist
+type wordlistEntry struct {
+	word   string
+	levels radix.Levels
+}
+
+// readWordlist parses a wordlist, returning its entries in order
+func readWordlist(r io.Reader) ([]wordlistEntry, error) {
+	scanner := bufio.NewScanner(r)
+
+	// The index into the levels of each column after the first
+	var columns []int
+	var entries []wordlistEntry
+	lines := make(map[string]int)
+
+	line := 0
+	for scanner.Scan() {
+		line++
+		text := strings.TrimSpace(scanner.Text())
+		if text == "" {
+			continue
+		}
+		fields := strings.Split(text, ",")
+		for i := range fields {
+			fields[i] = strings.TrimSpace(fields[i])
+		}
+
+		if columns == nil {
+			var err error
+			if columns, err = wordlistColumns(fields); err != nil {
+				return nil, fmt.Errorf("moderation: wordlist line %d: %v", line, err)
+			}
+			continue
+		}
+
+		if len(fields) != len(columns)+1 {
+			return nil, fmt.Errorf("moderation: wordlist line %d: expected %d columns, got %d", line, len(columns)+1, len(fields))
+		}
+		word, err := normalizePhrase(fields[0])
+		if err != nil {
+			return nil, fmt.Errorf("moderation: wordlist line %d: %v", line, err)
+		}
+		if previous, ok := lines[word]; ok {
+			return nil, fmt.Errorf("moderation: wordlist line %d: %q is already on line %d", line, word, previous)
+		}
+		lines[word] = line
+
+		entry := wordlistEntry{word: word}
+		for i, field := range fields[1:] {
+			level, err := strconv.ParseInt(field, 10, 8)
+			if err != nil {
+				return nil, fmt.Errorf("moderation: wordlist line %d: invalid level %q for %q", line, field, word)
+			}
+			entry.levels[columns[i]] = int8(level)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("moderation: wordlist line %d: %v", line+1, err)
+	}
+	if columns == nil {
+		return nil, errors.New("moderation: wordlist has no header")
+	}
+	return entries, nil
+}
+
+// wordlistColumns returns the index into the levels of each column of a
+// wordlist header after the first
+func wordlistColumns(header []string) ([]int, error) {
+	if header[0] != "word" {
+		return nil, fmt.Errorf("expected header starting with \"word\", got %q", header[0])
+	}
+	columns := make([]int, 0, len(header)-1)
+	seen := make(map[int]bool)
+	for _, name := range header[1:] {
+		category, ok := LookupCategory(name)
+		if !ok {
+			return nil, fmt.Errorf("unknown category %q", name)
+		}
+		index := categoryIndex(category)
+		if seen[index] {
+			return nil, fmt.Errorf("category %q is repeated", name)
+		}
+		seen[index] = true
+		columns = append(columns, index)
+	}
+	return columns, nil
+}
+
+// categoryIndex returns the index of a category's levels
+func categoryIndex(category Type) int {
+	for i := 0; i < countableTypes; i++ {
+		if category&(0b111<<(i*3)) != 0 {
+			return i
+		}
+	}
+	return -1
+}
@@ -0,0 +1,95 @@
+package moderation
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestLoadWordlist(t *testing.T) {
+	// The generated wordlist is the same as the default dictionary
+	file, err := os.Open("wordlists.csv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer file.Close()
+	filter, err := LoadWordlist(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if filter.tree.Len() != defaultFilter().tree.Len() {
+		t.Errorf("loaded %d words, expected %d", filter.tree.Len(), defaultFilter().tree.Len())
+	}
+	phrases := []string{"hello", "sh1t", "you're a dumbass", "assassin", "go die", "go diet", "I will kill you"}
+	for _, phrase := range phrases {
+		if filter.Scan(phrase) != Scan(phrase) {
+			t.Errorf("phrase=\"%s\" loaded=%b default=%b", phrase, filter.Scan(phrase), Scan(phrase))
+		}
+	}
+}
+
+func TestLoadWordlistInvalid(t *testing.T) {
+	type TestCase struct {
+		wordlist string
+		line     int
+	}
+	testCases := []TestCase{
+		{"", 0},
+		{"words,profane\n", 1},
+		{"word,profane,nonexistent\n", 1},
+		{"word,profane,profane\n", 1},
+		{"word,profane\nfoo,1\nbar\n", 3},
+		{"word,profane\nfoo,1\n\nbar,1,2\n", 4},
+		{"word,profane\nfoo,x\n", 2},
+		{"word,profane\nfoo,128\n", 2},
+		{"word,profane\nf00,1\n", 2},
+		{"word,profane\nfoo,1\nFoo,2\n", 3},
+	}
+	for _, testCase := range testCases {
+		_, err := LoadWordlist(strings.NewReader(testCase.wordlist))
+		if err == nil {
+			t.Errorf("wordlist=\"%s\" expected error", testCase.wordlist)
+		} else if testCase.line > 0 && !strings.Contains(err.Error(), fmt.Sprintf("line %d:", testCase.line)) {
+			t.Errorf("wordlist=\"%s\" error=\"%s\" expected line %d", testCase.wordlist, err, testCase.line)
+		}
+	}
+}
+
+func TestFilterMergeWordlist(t *testing.T) {
+	filter := NewFilter()
+	gambling := category(t, "gambling")
+	wordlist := "word          , mean, gambling\n" +
+		"noobface      ,    2,        0\n" +
+		"place your bets,   0,        2\n" +
+		"shit          ,    0,        0\n"
+	if err := filter.MergeWordlist(strings.NewReader(wordlist)); err != nil {
+		t.Fatal(err)
+	}
+	if !filter.Scan("noobface").Is(Mean&Moderate) || !filter.Scan("place your bets").Is(gambling&Moderate) {
+		t.Error("merged words not detected")
+	}
+	if filter.Scan("shit").Is(Profane) {
+		t.Error("expected merged word to replace levels")
+	}
+	if !filter.Scan("fuck").Is(Profane) {
+		t.Error("expected existing words to be kept")
+	}
+
+	// Invalid wordlists leave the dictionary unchanged
+	if filter.MergeWordlist(strings.NewReader("word,mean\nnoob,3\nn00b,3\n")) == nil {
+		t.Error("expected error")
+	}
+	if filter.Scan("noob").Is(Mean) {
+		t.Error("invalid wordlist was partially merged")
+	}
+}
+
+func ExampleLoadWordlist() {
+	filter, err := LoadWordlist(strings.NewReader("word,mean\nnoob,1\ngo die,3\n"))
+	if err != nil {
+		panic(err)
+	}
+	fmt.Println(filter.Scan("noob").Is(Mean), filter.Scan("go die").Is(Mean&Severe), filter.Scan("shit").Is(Profane))
+	// Output: true true false
+}
@@ -0,0 +1,208 @@
+package moderation
+
+import (
+	"errors"
+	"github.com/finnbear/moderation/internal/radix"
+	"io"
+	"sort"
+	"unicode/utf8"
+)
+
+// CensorWriter censors text as it is written through it to another
+// io.Writer, holding back only the text that may still be part of an
+// inappropriate word
+//
+// At most a few hundred bytes are held back, so a word that is spread over
+// more text than that (e.g. by thousands of separators between its
+// letters) may not be censored, even though Censor would censor it.
+//
+// Unlike Censor, which considers the text as a whole, a word is censored if
+// the text up to and including it is of the given types. Close must be called
+// to write the text that is held back. A CensorWriter must not be used
+// concurrently.
+type CensorWriter struct {
+	w        io.Writer
+	types    Type
+	strategy CensorStrategy
+	scanner  Scanner
+
+	// Text that has been scanned, but not written to w, which starts at
+	// offset base in the stream
+	buf  []byte
+	base int
+
+	// Hits that might still be combined with hits that are yet to be found
+	pending []hit
+
+	err error
+}
+
+var errClosed = errors.New("moderation: write to closed CensorWriter")
+
+// maxHoldback is the number of bytes a CensorWriter holds back, beyond which
+// it forgets where words that are yet to be found started. It allows for the
+// longest word, with letters of several bytes each (e.g. accented), and a few
+// separators between each (e.g. "s - h - i - t").
+const maxHoldback = radix.LongestWord * 4 * utf8.UTFMax
+
+// NewCensorWriter returns a CensorWriter that uses the default dictionary
+//
+// Equivalent to calling NewCensorWriter on a Filter returned by NewFilter
+func NewCensorWriter(w io.Writer, types Type, opts ...Option) *CensorWriter {
+	return defaultFilter().NewCensorWriter(w, types, opts...)
+}
+
+// NewCensorWriter returns a CensorWriter that writes text to w, censoring any
+// segment of the given types the same way as Censor
+//
+// The filter must not be modified while the CensorWriter is in use.
+func (filter *Filter) NewCensorWriter(w io.Writer, types Type, opts ...Option) *CensorWriter {
+	writer := &CensorWriter{
+		w:       w,
+		types:   types,
+		scanner: *filter.NewScanner(opts...),
+	}
+	writer.strategy = writer.scanner.options.strategy
+	if writer.strategy == nil {
+		writer.strategy = MaskKeepFirst(writer.scanner.options.replacement)
+	}
+	writer.scanner.state.onHit = func(hit hit) {
+		writer.pending = append(writer.pending, hit)
+	}
+	return writer
+}
+
+// Write censors p as the continuation of any text written so far, writing as
+// much as can be censored to the underlying io.Writer
+func (writer *CensorWriter) Write(p []byte) (int, error) {
+	if writer.err != nil {
+		return 0, writer.err
+	}
+	writer.buf = append(writer.buf, p...)
+	writer.scanner.Write(p)
+	if err := writer.flush(false); err != nil {
+		// p was accepted, even if not all of it could be written
+		return len(p), err
+	}
+	return len(p), nil
+}
+
+// Close censors and writes any text that was held back, without closing the
+// underlying io.Writer
+func (writer *CensorWriter) Close() error {
+	if writer.err != nil {
+		if writer.err == errClosed {
+			return nil
+		}
+		return writer.err
+	}
+	writer.scanner.state.finish()
+	if err := writer.flush(true); err != nil {
+		return err
+	}
+	writer.err = errClosed
+	return nil
+}
+
+// flush writes as much text as can be censored to the underlying io.Writer,
+// or all of it if final
+func (writer *CensorWriter) flush(final bool) error {
+	state := &writer.scanner.state
+
+	// Hits that are yet to be found may not start before bound, so that text
+	// isn't held back forever (e.g. "f" followed by a million separators)
+	bound := writer.scanner.offset - maxHoldback
+	if !final && bound > writer.base {
+		state.forget(bound)
+	}
+
+	// The earliest offset that a hit that is yet to be counted may start at
+	earliest := writer.scanner.offset
+	for m := 0; m < state.matches.Len(); m++ {
+		match := state.matches.At(m)
+		if depth := match.Node.Depth(); depth > 0 && match.Start(depth) < earliest {
+			earliest = match.Start(depth)
+		}
+	}
+	for _, hit := range state.hits {
+		if hit.start < earliest {
+			earliest = hit.start
+		}
+	}
+
+	sort.Slice(writer.pending, func(i, j int) bool {
+		return writer.pending[i].start < writer.pending[j].start
+	})
+
+	// Hits are only censored once the cluster they are part of can no longer
+	// grow (see censorMatches)
+	limit := earliest
+	ready := len(writer.pending)
+	if final {
+		limit = writer.base + len(writer.buf)
+	} else {
+		ready = 0
+		for ready < len(writer.pending) {
+			next := ready + 1
+			end := writer.pending[ready].end
+			for next < len(writer.pending) && writer.pending[next].start <= end {
+				if writer.pending[next].end > end {
+					end = writer.pending[next].end
+				}
+				next++
+			}
+			if end >= earliest {
+				// A hit that is yet to be counted could overlap or touch it
+				break
+			}
+			ready = next
+		}
+
+		// A cluster that started before bound (e.g. "fuckfuckfuck...") is
+		// censored in parts, split where no hit crosses from one to the next
+		end := 0
+		for i := ready; i < len(writer.pending) && writer.pending[i].start < bound; i++ {
+			if writer.pending[i].end > end {
+				end = writer.pending[i].end
+			}
+			if end > bound {
+				break
+			}
+			if i+1 == len(writer.pending) || writer.pending[i+1].start >= end {
+				ready = i + 1
+			}
+		}
+		if ready < len(writer.pending) && writer.pending[ready].start < limit {
+			limit = writer.pending[ready].start
+		}
+	}
+	if limit <= writer.base {
+		return nil
+	}
+
+	// Censor relative to the start of the buffer
+	text := string(writer.buf[:limit-writer.base])
+	hits := writer.pending[:ready]
+	for i := range hits {
+		hits[i].start -= writer.base
+		hits[i].end -= writer.base
+	}
+
+	end := 0
+	for _, match := range censorMatches(text, hits, state.result()&writer.types, state.thresholds) {
+		if _, err := io.WriteString(writer.w, text[end:match.Start]+writer.strategy(match)); err != nil {
+			writer.err = err
+			return err
+		}
+		end = match.End
+	}
+	if _, err := io.WriteString(writer.w, text[end:]); err != nil {
+		writer.err = err
+		return err
+	}
+
+	writer.buf = writer.buf[:copy(writer.buf, writer.buf[limit-writer.base:])]
+	writer.base = limit
+	writer.pending = writer.pending[:copy(writer.pending, writer.pending[ready:])]
+	return nil
+}
@@ -0,0 +1,149 @@
+package moderation
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"testing"
+)
+
+func TestCensorWriter(t *testing.T) {
+	phrases := []string{
+		"hello there",
+		"what the fuck, shit",
+		"what the fučk",
+		"what the f*u*c*k",
+		"you're a dumbass",
+		"you ass, assassin",
+		"βιτ⊂η please",
+		"sh1t",
+		"just go die",
+		"go die, ok",
+	}
+	for _, phrase := range phrases {
+		expected, _ := Censor(phrase, Inappropriate)
+
+		// Split the phrase at every byte, including in the middle of runes
+		for i := 0; i <= len(phrase); i++ {
+			var buf bytes.Buffer
+			writer := NewCensorWriter(&buf, Inappropriate)
+			writer.Write([]byte(phrase[:i]))
+			writer.Write([]byte(phrase[i:]))
+			if err := writer.Close(); err != nil {
+				t.Fatal(err)
+			}
+			if buf.String() != expected {
+				t.Errorf("phrase=\"%s\" split at %d censored=\"%s\" expected=\"%s\"", phrase, i, buf.String(), expected)
+			}
+		}
+	}
+}
+
+func TestCensorWriterHoldsBack(t *testing.T) {
+	var buf bytes.Buffer
+	writer := NewCensorWriter(&buf, Inappropriate, WithStrategy(Token("[removed]")))
+
+	// Only text that could be part of a word is held back, which includes
+	// separators as they may be inside a word (e.g. "s h i t")
+	writer.Write([]byte("hello there, "))
+	if buf.String() != "hello " {
+		t.Errorf("wrote \"%s\" before word", buf.String())
+	}
+	writer.Write([]byte("what the sh"))
+	if buf.String() != "hello there, wha" {
+		t.Errorf("wrote \"%s\" before end of word", buf.String())
+	}
+	writer.Write([]byte("it is that? "))
+	if buf.String() != "hello there, what the [removed] is that? " {
+		t.Errorf("wrote \"%s\" after word", buf.String())
+	}
+
+	// Long streams don't build up
+	for i := 0; i < 1000; i++ {
+		io.WriteString(writer, "The quick brown fox jumps over the lazy dog. ")
+	}
+	if len(writer.buf) > 10 || len(writer.pending) > 1 {
+		t.Errorf("%d bytes and %d hits held", len(writer.buf), len(writer.pending))
+	}
+
+	writer.Close()
+	if !strings.HasSuffix(buf.String(), "lazy dog. ") {
+		t.Errorf("wrote \"%s\" after close", buf.String()[buf.Len()-20:])
+	}
+	if _, err := writer.Write([]byte("more")); err == nil {
+		t.Error("expected error writing after close")
+	}
+}
+
+func TestCensorWriterBoundedHoldback(t *testing.T) {
+	type TestCase struct {
+		first  string
+		repeat string
+	}
+	testCases := []TestCase{
+		{"s", "h"},
+		{"a", " "},
+		{"f", "."},
+		{"", "fuck"},
+		{"", "f u c k "},
+	}
+	for _, testCase := range testCases {
+		var buf bytes.Buffer
+		writer := NewCensorWriter(&buf, Inappropriate)
+		chunk := []byte(strings.Repeat(testCase.repeat, 4096/len(testCase.repeat)))
+		writer.Write([]byte(testCase.first))
+		written := len(testCase.first)
+		held := 0
+		for written < 1<<22 {
+			writer.Write(chunk)
+			written += len(chunk)
+			if len(writer.buf) > held {
+				held = len(writer.buf)
+			}
+		}
+		if held > 2*maxHoldback+len(chunk) {
+			t.Errorf("phrase=\"%s\" held back %d bytes", testCase.first+testCase.repeat, held)
+		}
+		writer.Close()
+		if buf.Len() != written {
+			t.Errorf("phrase=\"%s\" wrote %d bytes, expected %d", testCase.first+testCase.repeat, buf.Len(), written)
+		} else if strings.Contains(buf.String(), "fuck") || strings.Contains(buf.String(), "f u c k") {
+			t.Errorf("phrase=\"%s\" not censored", testCase.first+testCase.repeat)
+		}
+	}
+}
+
+type failingWriter struct{}
+
+func (failingWriter) Write(p []byte) (int, error) {
+	return 0, io.ErrShortWrite
+}
+
+func TestCensorWriterError(t *testing.T) {
+	writer := NewCensorWriter(failingWriter{}, Inappropriate)
+	if n, err := writer.Write([]byte("hello world")); err == nil || n != 11 {
+		t.Errorf("wrote %d with error %v, expected 11 with error", n, err)
+	}
+	if n, err := writer.Write([]byte("more")); err == nil || n != 0 {
+		t.Errorf("wrote %d with error %v after error, expected 0 with error", n, err)
+	}
+}
+
+func ExampleCensorWriter() {
+	writer := NewCensorWriter(os.Stdout, Inappropriate)
+	io.Copy(writer, strings.NewReader("what the sh1t\n"))
+	writer.Close()
+	// Output: what the s***
+}
+
+func ExampleNewCensorWriter() {
+	var buf bytes.Buffer
+	writer := NewCensorWriter(&buf, Inappropriate, WithReplacement('#'))
+	writer.Write([]byte("what the f"))
+	writer.Write([]byte("uck"))
+	writer.Close()
+	fmt.Println(buf.String())
+	// Output: what the f###
+}